@@ -27,6 +27,11 @@ const (
 	numBrainInputs = 12
 	// the number of outputs to feed back as inputs to the brain
 	memorySize = 12
+	// the number of weights in a brain, see GetWeights. Each input layer
+	// node has a weight per input, per memory value and for the bias, and
+	// each output layer node a weight per input layer node and for the bias.
+	numBrainWeights = (numBrainInputs+memorySize)*(numBrainInputs+memorySize+1) +
+		(memorySize+2)*(numBrainInputs+memorySize+1)
 )
 
 // Perceptron is a simple perceptron
@@ -71,7 +76,8 @@ type Brain struct {
 // output layer has memorySize + 2 nodes where the first
 // two output nodes are used for control (actual output)
 // and the remainder are used for memory.
-func NewRandomBrain() *Brain {
+// The weights are generated with rng.
+func NewRandomBrain(rng *rand.Rand) *Brain {
 	b := Brain{
 		inLayer:  make([]Perceptron, numBrainInputs+memorySize),
 		outLayer: make([]Perceptron, memorySize+2),
@@ -94,14 +100,14 @@ func NewRandomBrain() *Brain {
 	for i := range b.inLayer {
 		offset := i * inWeightLen
 		for j := 0; j < inWeightLen; j++ {
-			b.allWeights[offset+j] = rand.Float64()*2 - 1
+			b.allWeights[offset+j] = rng.Float64()*2 - 1
 		}
 		b.inLayer[i] = NewPerceptron(b.allWeights[offset : offset+inWeightLen])
 	}
 	for i := range b.outLayer {
 		offset := len(b.inLayer)*inWeightLen + outWeightLen*i
 		for j := 0; j < outWeightLen; j++ {
-			b.allWeights[offset+j] = rand.Float64()*2 - 1
+			b.allWeights[offset+j] = rng.Float64()*2 - 1
 		}
 		b.outLayer[i] = NewPerceptron(b.allWeights[offset : offset+outWeightLen])
 	}
@@ -178,6 +184,12 @@ func (b *Brain) Step(input []float64) (turn, move float64) {
 	return b.output[0], b.output[1]
 }
 
+// Memory returns the recurrent outputs from the last step, which are fed
+// back as inputs to the next step. The slice is updated by each Step.
+func (b *Brain) Memory() []float64 {
+	return b.output[2:]
+}
+
 // GetWeights returns a slice of all weights in the brain
 // The slice contains the weights in order of inLayer first then
 // outLayer and within each layer the weights of the Perceptrons
@@ -205,11 +217,11 @@ func (b *Brain) SetWeights(allWeights []float64) {
 	}
 }
 
-// RandomizeWeights randomizes the Brain's weights
-func (b *Brain) RandomizeWeights() {
+// RandomizeWeights randomizes the Brain's weights using rng
+func (b *Brain) RandomizeWeights(rng *rand.Rand) {
 	lenAllWeights := len(b.allWeights)
 	for i := 0; i < lenAllWeights; i++ {
-		b.allWeights[i] = rand.Float64()*2 - 1
+		b.allWeights[i] = rng.Float64()*2 - 1
 	}
 }
 
@@ -0,0 +1,159 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image"
+	"math"
+)
+
+// camera limits and steps
+const (
+	maxZoom  = 8
+	zoomStep = 1.25 // the zoom factor of a key press or scroll step
+	panStep  = 32   // the distance in view pixels of a key press
+)
+
+// Camera maps the simulation frame to the view a RasterRenderer draws,
+// zooming in on and panning around the arena. At a zoom of 1 the whole
+// frame fits the view, and the view is kept inside the frame when zoomed in.
+type Camera struct {
+	// Zoom is the magnification relative to fitting the frame in the view,
+	// from 1 to maxZoom
+	Zoom float64
+	// X, Y is the frame position at the center of the view
+	X, Y float64
+	// Follow is the ID of a creature to keep centered, zero for none
+	Follow int
+	// the mapping from frame to view coordinates computed by update,
+	// view = frame*scale + d
+	scale, dx, dy float64
+	// the view size
+	width, height float64
+}
+
+// panelArea is the rectangle of the view in view coordinates that panels
+// are laid out in, the visible part of the arena inside the border
+type panelArea struct {
+	left, top, right, bottom float64
+}
+
+// update follows the followed creature, keeps the zoom and position in
+// bounds and computes the mapping from the frame of w to view
+func (c *Camera) update(w *WorldState, view image.Rectangle) {
+	frame := w.FrameBounds()
+	fw, fh := float64(frame.Dx()), float64(frame.Dy())
+	c.width, c.height = float64(view.Dx()), float64(view.Dy())
+	c.Zoom = math.Max(1, math.Min(c.Zoom, maxZoom))
+	c.scale = math.Min(c.width/fw, c.height/fh) * c.Zoom
+	if c.Follow != 0 {
+		found := false
+		for i := range w.Creatures {
+			if cr := &w.Creatures[i]; cr.ID == c.Follow {
+				c.X = float64(w.BorderWidth) + cr.X
+				c.Y = float64(w.BorderWidth) + cr.Y
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.Follow = 0
+		}
+	}
+	c.X = clampCenter(c.X, fw, c.width/c.scale)
+	c.Y = clampCenter(c.Y, fh, c.height/c.scale)
+	c.remap()
+}
+
+// remap updates the mapping to the view after the position or zoom changed,
+// until the next update clamps them
+func (c *Camera) remap() {
+	c.dx = c.width/2 - c.X*c.scale
+	c.dy = c.height/2 - c.Y*c.scale
+}
+
+// clampCenter returns the center x of a span within [0, size], or the
+// center of [0, size] if the span is larger
+func clampCenter(x, size, span float64) float64 {
+	if span >= size {
+		return size / 2
+	}
+	return math.Max(span/2, math.Min(x, size-span/2))
+}
+
+// area returns the panel area for w, see panelArea
+func (c *Camera) area(w *WorldState) panelArea {
+	borderWidthf := float64(w.BorderWidth)
+	left, top := c.ToView(borderWidthf, borderWidthf)
+	right, bottom := c.ToView(borderWidthf+float64(w.Width), borderWidthf+float64(w.Height))
+	return panelArea{
+		left:   math.Max(left, 0),
+		top:    math.Max(top, 0),
+		right:  math.Min(right, c.width),
+		bottom: math.Min(bottom, c.height),
+	}
+}
+
+// ToView converts the frame position fx, fy to view coordinates
+func (c *Camera) ToView(fx, fy float64) (vx, vy float64) {
+	if c.scale == 0 {
+		return fx, fy
+	}
+	return fx*c.scale + c.dx, fy*c.scale + c.dy
+}
+
+// ToFrame converts the view position vx, vy to frame coordinates
+func (c *Camera) ToFrame(vx, vy float64) (fx, fy float64) {
+	if c.scale == 0 {
+		return vx, vy
+	}
+	return (vx - c.dx) / c.scale, (vy - c.dy) / c.scale
+}
+
+// ZoomAt multiplies the zoom by factor keeping the frame position under
+// the view position vx, vy in place, or the followed creature centered
+func (c *Camera) ZoomAt(vx, vy, factor float64) {
+	if c.scale == 0 {
+		return
+	}
+	fx, fy := c.ToFrame(vx, vy)
+	zoom := math.Max(1, math.Min(c.Zoom*factor, maxZoom))
+	c.scale = c.scale / c.Zoom * zoom
+	c.Zoom = zoom
+	if c.Follow == 0 {
+		c.X = fx - (vx-c.width/2)/c.scale
+		c.Y = fy - (vy-c.height/2)/c.scale
+	}
+	c.remap()
+}
+
+// Pan moves the view by dx, dy view pixels and stops following
+func (c *Camera) Pan(dx, dy float64) {
+	if c.scale == 0 {
+		return
+	}
+	c.Follow = 0
+	c.X -= dx / c.scale
+	c.Y -= dy / c.scale
+	c.remap()
+}
+
+// Reset zooms out to fit the whole frame and stops following
+func (c *Camera) Reset() {
+	c.Zoom = 1
+	c.Follow = 0
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image"
+	"math"
+	"testing"
+)
+
+// near returns whether a and b are equal but for rounding
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestCameraRoundTrip(t *testing.T) {
+	// a 420x320 frame
+	w := &WorldState{Width: 400, Height: 300, BorderWidth: 10}
+	for _, tc := range []struct {
+		name string
+		cam  Camera
+		view image.Rectangle
+	}{
+		{"fit width", Camera{Zoom: 1}, image.Rect(0, 0, 840, 1000)},
+		{"fit height", Camera{Zoom: 1}, image.Rect(0, 0, 2000, 640)},
+		{"zoomed", Camera{Zoom: 3, X: 100, Y: 200}, image.Rect(0, 0, 840, 1000)},
+		{"zoomed at the edge", Camera{Zoom: 8, X: 0, Y: 1000}, image.Rect(0, 0, 405, 720)},
+	} {
+		cam := tc.cam
+		cam.update(w, tc.view)
+		for _, p := range [][2]float64{{0, 0}, {420, 320}, {210, 160}, {13.5, 301.25}} {
+			vx, vy := cam.ToView(p[0], p[1])
+			if fx, fy := cam.ToFrame(vx, vy); !near(fx, p[0]) || !near(fy, p[1]) {
+				t.Errorf("%s: frame (%v, %v) went to view (%v, %v) and back to (%v, %v)",
+					tc.name, p[0], p[1], vx, vy, fx, fy)
+			}
+			fx, fy := cam.ToFrame(p[0], p[1])
+			if vx, vy := cam.ToView(fx, fy); !near(vx, p[0]) || !near(vy, p[1]) {
+				t.Errorf("%s: view (%v, %v) went to frame (%v, %v) and back to (%v, %v)",
+					tc.name, p[0], p[1], fx, fy, vx, vy)
+			}
+		}
+	}
+}
+
+func TestCameraFit(t *testing.T) {
+	w := &WorldState{Width: 400, Height: 300, BorderWidth: 10}
+	for _, tc := range []struct {
+		name   string
+		view   image.Rectangle
+		x0, y0 float64 // the view position of the frame origin
+		x1, y1 float64 // the view position of the far frame corner
+	}{
+		{"fit width", image.Rect(0, 0, 840, 1000), 0, 180, 840, 820},
+		{"fit height", image.Rect(0, 0, 2000, 640), 580, 0, 1420, 640},
+	} {
+		cam := Camera{Zoom: 1, X: 50, Y: 50}
+		cam.update(w, tc.view)
+		x0, y0 := cam.ToView(0, 0)
+		x1, y1 := cam.ToView(420, 320)
+		if !near(x0, tc.x0) || !near(y0, tc.y0) || !near(x1, tc.x1) || !near(y1, tc.y1) {
+			t.Errorf("%s: the frame is at (%v, %v)-(%v, %v), want (%v, %v)-(%v, %v)",
+				tc.name, x0, y0, x1, y1, tc.x0, tc.y0, tc.x1, tc.y1)
+		}
+	}
+}
+
+func TestCameraBounds(t *testing.T) {
+	w := &WorldState{Width: 400, Height: 300, BorderWidth: 10}
+	view := image.Rect(0, 0, 840, 1000)
+	for _, pos := range [][2]float64{{-500, -500}, {1000, 1000}, {0, 160}, {420, 0}} {
+		cam := Camera{Zoom: 4, X: pos[0], Y: pos[1]}
+		cam.update(w, view)
+		// the view is kept inside the frame
+		fx0, fy0 := cam.ToFrame(0, 0)
+		fx1, fy1 := cam.ToFrame(840, 1000)
+		if fx0 < -1e-9 || fy0 < -1e-9 || fx1 > 420+1e-9 || fy1 > 320+1e-9 {
+			t.Errorf("centered at %v: the view is (%v, %v)-(%v, %v)", pos, fx0, fy0, fx1, fy1)
+		}
+	}
+	// a view larger than the zoomed frame is centered on it
+	cam := Camera{Zoom: 1, X: 0, Y: 0}
+	cam.update(w, view)
+	if !near(cam.X, 210) || !near(cam.Y, 160) {
+		t.Errorf("the view is centered at (%v, %v), want (210, 160)", cam.X, cam.Y)
+	}
+	// zoom is kept within [1, maxZoom]
+	for _, zoom := range []float64{0, 0.5, maxZoom * 2} {
+		cam := Camera{Zoom: zoom}
+		cam.update(w, view)
+		if cam.Zoom < 1 || cam.Zoom > maxZoom {
+			t.Errorf("zoom %v became %v", zoom, cam.Zoom)
+		}
+	}
+}
+
+func TestCameraZoomAt(t *testing.T) {
+	w := &WorldState{Width: 400, Height: 300, BorderWidth: 10}
+	cam := Camera{Zoom: 2, X: 210, Y: 160}
+	cam.update(w, image.Rect(0, 0, 840, 1000))
+	fx, fy := cam.ToFrame(300, 400)
+	cam.ZoomAt(300, 400, zoomStep)
+	if cam.Zoom != 2*zoomStep {
+		t.Errorf("zoom %v, want %v", cam.Zoom, 2*zoomStep)
+	}
+	// the frame position under the view position stays put
+	if gx, gy := cam.ToFrame(300, 400); !near(gx, fx) || !near(gy, fy) {
+		t.Errorf("view (300, 400) moved from frame (%v, %v) to (%v, %v)", fx, fy, gx, gy)
+	}
+	cam.Pan(40, -20)
+	if gx, gy := cam.ToFrame(340, 380); !near(gx, fx) || !near(gy, fy) {
+		t.Errorf("panning moved frame (%v, %v) to view (340, 380) at (%v, %v)", fx, fy, gx, gy)
+	}
+}
+
+func TestCameraFollow(t *testing.T) {
+	w := &WorldState{Width: 400, Height: 300, BorderWidth: 10,
+		Creatures: []CreatureState{{ID: 3, X: 50, Y: 60}, {ID: 4, X: 200, Y: 150}}}
+	view := image.Rect(0, 0, 840, 1000)
+	cam := Camera{Zoom: 4, Follow: 4}
+	cam.update(w, view)
+	// the followed creature is at the center of the view
+	if vx, vy := cam.ToView(10+200, 10+150); !near(vx, 420) || !near(vy, 500) {
+		t.Errorf("the followed creature is at view (%v, %v), want (420, 500)", vx, vy)
+	}
+	// zooming keeps it centered
+	cam.ZoomAt(0, 0, zoomStep)
+	if vx, vy := cam.ToView(10+200, 10+150); !near(vx, 420) || !near(vy, 500) {
+		t.Errorf("after zooming the followed creature is at view (%v, %v), want (420, 500)", vx, vy)
+	}
+	// following stops when the creature is gone
+	w.Creatures = w.Creatures[:1]
+	cam.update(w, view)
+	if cam.Follow != 0 {
+		t.Errorf("following %d after it died", cam.Follow)
+	}
+	// and when panning
+	cam.Follow = 3
+	cam.update(w, view)
+	cam.Pan(10, 0)
+	if cam.Follow != 0 {
+		t.Errorf("following %d after panning", cam.Follow)
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/llgcode/draw2d"
+	"github.com/llgcode/draw2d/draw2dkit"
+)
+
+// historyLength is the number of evolution cycles kept in a History
+const historyLength = 120
+
+// chart panel layout, in frame pixels
+const (
+	chartWidth  = 180
+	chartHeight = 56
+)
+
+var (
+	chartBestColor      = color.RGBA{0xD0, 0x30, 0x30, 0xFF}
+	chartMeanColor      = color.RGBA{0x30, 0x50, 0xD0, 0xFF}
+	chartDiversityColor = color.RGBA{0x30, 0x90, 0x30, 0xFF}
+)
+
+// HistorySample holds the statistics recorded at the start of an
+// evolution cycle
+type HistorySample struct {
+	Cycle int
+	// The best and mean score in the hall of fame
+	BestScore int64
+	MeanScore float64
+	// Diversity is the mean distance of the live creatures' genomes from
+	// their mean genome
+	Diversity float64
+}
+
+// History is an Observer recording a HistorySample each evolution cycle,
+// keeping the last historyLength samples for charting
+type History struct {
+	BaseObserver
+	Samples []HistorySample
+	mean    []float64 // for computing the diversity
+}
+
+// OnEvolutionCycle implements Observer by recording a sample
+func (h *History) OnEvolutionCycle(s *Sim, cycle int) {
+	sample := HistorySample{Cycle: cycle}
+	if len(s.bestCreatures) > 0 {
+		sample.BestScore = s.bestCreatures[0].score
+		var total int64
+		for _, t := range s.bestCreatures {
+			total += t.score
+		}
+		sample.MeanScore = float64(total) / float64(len(s.bestCreatures))
+	}
+	sample.Diversity = h.diversity(s)
+	if len(h.Samples) == historyLength {
+		copy(h.Samples, h.Samples[1:])
+		h.Samples = h.Samples[:historyLength-1]
+	}
+	h.Samples = append(h.Samples, sample)
+}
+
+// diversity returns the mean euclidean distance of the live creatures'
+// weights from their mean weights
+func (h *History) diversity(s *Sim) float64 {
+	if len(s.creatures) == 0 {
+		return 0
+	}
+	h.mean = h.mean[:0]
+	for i := 0; i < numBrainWeights; i++ {
+		h.mean = append(h.mean, 0)
+	}
+	n := float64(len(s.creatures))
+	for _, c := range s.creatures {
+		for i, w := range c.brain.GetWeights() {
+			h.mean[i] += w / n
+		}
+	}
+	total := 0.0
+	for _, c := range s.creatures {
+		sum := 0.0
+		for i, w := range c.brain.GetWeights() {
+			d := w - h.mean[i]
+			sum += d * d
+		}
+		total += math.Sqrt(sum)
+	}
+	return total / n
+}
+
+// Charts draws line charts of a History in the bottom right corner of the
+// arena: the best and mean hall of fame score, and the population diversity
+// for each evolution cycle.
+type Charts struct {
+	Enabled bool
+	History *History
+}
+
+// layout returns the view rectangle of the charts panel, which sits in the
+// bottom right corner of area
+func (ch *Charts) layout(area panelArea) (left, top, right, bottom float64) {
+	right = area.right - overlayPadding
+	left = right - chartWidth
+	bottom = area.bottom - overlayPadding
+	top = bottom - 2*(textLineHeight+chartHeight) - 3*overlayPadding
+	return left, top, right, bottom
+}
+
+// Draw draws the charts to gc in the bottom right of area
+func (ch *Charts) Draw(gc draw2d.GraphicContext, w *WorldState, area panelArea) {
+	if !ch.Enabled || ch.History == nil || len(ch.History.Samples) == 0 {
+		return
+	}
+	samples := ch.History.Samples
+	last := samples[len(samples)-1]
+	left, top, right, bottom := ch.layout(area)
+	gc.Save()
+	defer gc.Restore()
+	gc.SetFillColor(overlayPanelColor)
+	draw2dkit.Rectangle(gc, left, top, right, bottom)
+	gc.Fill()
+	x := left + overlayPadding
+	y := top + overlayPadding
+	width := float64(chartWidth - 2*overlayPadding)
+	// scores
+	gc.SetFillColor(chartBestColor)
+	tx := x + fillText(gc, fmt.Sprintf("best %d ", last.BestScore), x, y)
+	gc.SetFillColor(chartMeanColor)
+	fillText(gc, fmt.Sprintf("mean %.0f", last.MeanScore), tx, y)
+	y += textLineHeight
+	maxScore := 1.0
+	for _, s := range samples {
+		maxScore = math.Max(maxScore, float64(s.BestScore))
+	}
+	drawChartLine(gc, x, y, width, chartHeight, len(samples), maxScore, chartBestColor,
+		func(i int) float64 { return float64(samples[i].BestScore) })
+	drawChartLine(gc, x, y, width, chartHeight, len(samples), maxScore, chartMeanColor,
+		func(i int) float64 { return samples[i].MeanScore })
+	y += chartHeight + overlayPadding
+	// diversity
+	gc.SetFillColor(chartDiversityColor)
+	fillText(gc, fmt.Sprintf("diversity %.2f", last.Diversity), x, y)
+	y += textLineHeight
+	maxDiversity := 1e-9
+	for _, s := range samples {
+		maxDiversity = math.Max(maxDiversity, s.Diversity)
+	}
+	drawChartLine(gc, x, y, width, chartHeight, len(samples), maxDiversity, chartDiversityColor,
+		func(i int) float64 { return samples[i].Diversity })
+}
+
+// drawChartLine strokes the n values returned by value as a line chart in
+// the rectangle at x, y of size width by height, scaled so that max is at
+// the top and spread over historyLength samples
+func drawChartLine(gc draw2d.GraphicContext, x, y, width, height float64, n int,
+	max float64, c color.Color, value func(i int) float64) {
+	gc.SetStrokeColor(overlayBarColor)
+	gc.SetLineWidth(1)
+	gc.MoveTo(x, y+height)
+	gc.LineTo(x+width, y+height)
+	gc.Stroke()
+	gc.SetStrokeColor(c)
+	for i := 0; i < n; i++ {
+		px := x + width*float64(i)/float64(historyLength-1)
+		py := y + height - height*value(i)/max
+		if i == 0 {
+			gc.MoveTo(px, py)
+		} else {
+			gc.LineTo(px, py)
+		}
+	}
+	gc.Stroke()
+}
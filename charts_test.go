@@ -0,0 +1,126 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// brainWith returns a brain with every weight set to v
+func brainWith(v float64) *Brain {
+	b := NewRandomBrain(rand.New(rand.NewSource(1)))
+	weights := make([]float64, numBrainWeights)
+	for i := range weights {
+		weights[i] = v
+	}
+	b.SetWeights(weights)
+	return b
+}
+
+func TestHistorySample(t *testing.T) {
+	s := &Sim{
+		bestCreatures: TopCreatures{{score: 30}, {score: 20}, {score: 10}},
+		creatures:     []*Creature{{brain: brainWith(0.5)}, {brain: brainWith(-0.5)}},
+	}
+	var h History
+	h.OnEvolutionCycle(s, 3)
+	// each creature's weights are 0.5 from the mean in every dimension
+	want := HistorySample{Cycle: 3, BestScore: 30, MeanScore: 20,
+		Diversity: 0.5 * math.Sqrt(numBrainWeights)}
+	if len(h.Samples) != 1 {
+		t.Fatalf("%d samples, want 1", len(h.Samples))
+	}
+	got := h.Samples[0]
+	if math.Abs(got.Diversity-want.Diversity) > 1e-9 {
+		t.Errorf("diversity %v, want %v", got.Diversity, want.Diversity)
+	}
+	got.Diversity = want.Diversity
+	if got != want {
+		t.Errorf("sample %+v, want %+v", got, want)
+	}
+
+	// an empty simulation has an empty sample
+	h.OnEvolutionCycle(&Sim{}, 4)
+	if got := h.Samples[1]; got != (HistorySample{Cycle: 4}) {
+		t.Errorf("sample %+v, want an empty sample", got)
+	}
+}
+
+func TestHistoryLength(t *testing.T) {
+	var h History
+	s := &Sim{}
+	for cycle := 0; cycle < historyLength+5; cycle++ {
+		h.OnEvolutionCycle(s, cycle)
+	}
+	if len(h.Samples) != historyLength {
+		t.Fatalf("%d samples, want %d", len(h.Samples), historyLength)
+	}
+	for i, sample := range h.Samples {
+		if sample.Cycle != i+5 {
+			t.Fatalf("sample %d is of cycle %d, want %d", i, sample.Cycle, i+5)
+		}
+	}
+}
+
+// viewArea returns the panel area of an arena of size arenaWidth by
+// arenaHeight in a view of size width by height
+func viewArea(arenaWidth, arenaHeight, width, height int) panelArea {
+	w := &WorldState{Width: arenaWidth, Height: arenaHeight, BorderWidth: 16}
+	var cam Camera
+	cam.update(w, image.Rect(0, 0, width, height))
+	return cam.area(w)
+}
+
+func TestChartsBesideInspector(t *testing.T) {
+	w := &WorldState{Creatures: []CreatureState{{ID: 1}}}
+	var ch Charts
+	for _, tc := range []struct {
+		name     string
+		area     panelArea
+		selected int
+		// whether the charts are in the bottom corner of the area
+		bottom bool
+	}{
+		{"portrait", viewArea(405, 720, 405, 720), 1, false},
+		{"landscape", viewArea(720, 405, 720, 405), 1, true},
+		{"offset", panelArea{100, 50, 400, 770}, 1, false},
+		{"nothing selected", viewArea(405, 720, 405, 720), 0, true},
+		{"selected creature gone", viewArea(405, 720, 405, 720), 2, true},
+	} {
+		in := Inspector{Selected: tc.selected}
+		left, top, right, bottom := ch.layout(in.beside(w, tc.area, chartWidth))
+		if left < tc.area.left || top < tc.area.top || right > tc.area.right || bottom > tc.area.bottom {
+			t.Errorf("%s: the charts at (%v, %v)-(%v, %v) are outside the area",
+				tc.name, left, top, right, bottom)
+		}
+		if got := bottom == tc.area.bottom-overlayPadding; got != tc.bottom {
+			t.Errorf("%s: charts in the bottom corner %v, want %v", tc.name, got, tc.bottom)
+		}
+		if in.creature(w) == nil {
+			continue
+		}
+		inLeft, inTop, inHeight := in.layout(tc.area)
+		if left < inLeft+inspectorWidth && inLeft < right &&
+			top < inTop+inHeight && inTop < bottom {
+			t.Errorf("%s: the charts at (%v, %v)-(%v, %v) overlap the inspector at (%v, %v)-(%v, %v)",
+				tc.name, left, top, right, bottom, inLeft, inTop, inLeft+inspectorWidth, inTop+inHeight)
+		}
+	}
+}
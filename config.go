@@ -0,0 +1,270 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// Config holds the size and tuning parameters of a simulation
+type Config struct {
+	// The size of the simulation area and the thickness of its border
+	Width       int `json:"width"`
+	Height      int `json:"height"`
+	BorderWidth int `json:"border_width"`
+	// The radius of the simulated "creatures"
+	CreatureRadius int `json:"creature_radius"`
+	// The thickness of the moving obstacle lines
+	ObstacleWidth float64 `json:"obstacle_width"`
+	// The minimum number of creatures that must be alive
+	MinCreatures int `json:"min_creatures"`
+	// The limit for automatically spawning creatures each evolution cycle
+	MaxCreatures int `json:"max_creatures"`
+	// The limit for the creature hall of fame for evolution, when it is
+	// exceeded the hall of fame is trimmed to MaxCreatures+1 genomes
+	MaxBestCreatures int `json:"max_best_creatures"`
+	// The number of obstacles to spawn, when there are no Spawners
+	NumObstacles int `json:"num_obstacles"`
+	// The names of the kinds of obstacles to spawn, chosen from with equal
+	// chances, see ObstacleKind. Empty is only planks.
+	ObstacleKinds []string `json:"obstacle_kinds"`
+	// The names of the ways obstacles move, chosen from with equal chances,
+	// see ObstacleMotion. Empty is only drifting.
+	ObstacleMotions []string `json:"obstacle_motions"`
+	// The sources of moving obstacles, replacing NumObstacles,
+	// ObstacleKinds and ObstacleMotions if there are any
+	Spawners []ObstacleSpawner `json:"spawners,omitempty"`
+	// Where creatures spawn, anywhere in the simulation area if empty
+	SpawnRegions []Region `json:"spawn_regions,omitempty"`
+	// The number of ticks after which every creature and obstacle is
+	// removed to start again, zero to never restart
+	EpisodeTicks int `json:"episode_ticks,omitempty"`
+	// The number of simulation ticks between "evolution" spawning
+	EvolutionCycleTicks int `json:"evolution_cycle_ticks"`
+	// The chance of each weight of a mixed genome being replaced by a
+	// random weight
+	MutationRate float64 `json:"mutation_rate"`
+	// The turn in radians and the distance moved in a tick at the
+	// strongest brain outputs
+	TurnRate  float64 `json:"turn_rate"`
+	MoveSpeed float64 `json:"move_speed"`
+	// The static walls inside the simulation area
+	Layout Layout `json:"layout"`
+	// Changes the difficulty as the creatures improve
+	Curriculum Curriculum `json:"curriculum"`
+}
+
+// DefaultConfig returns the default simulation configuration.
+// The area seems to be plenty and smaller areas will be cheaper to run
+// especially on mobile.
+func DefaultConfig() Config {
+	return Config{
+		Width:               405,
+		Height:              720,
+		BorderWidth:         16,
+		CreatureRadius:      6,
+		ObstacleWidth:       3,
+		MinCreatures:        10,
+		MaxCreatures:        20,
+		MaxBestCreatures:    40,
+		NumObstacles:        6,
+		ObstacleKinds:       []string{ObstaclePlank.String()},
+		ObstacleMotions:     []string{MotionDrift.String()},
+		EvolutionCycleTicks: 30 * 5,
+		TurnRate:            1.0 / 8,
+		MoveSpeed:           4,
+	}
+}
+
+// hallOfFameSize returns the number of the best of n genomes to keep in a
+// hall of fame, which is trimmed to MaxCreatures+1 once it has more than
+// MaxBestCreatures
+func (c *Config) hallOfFameSize(n int) int {
+	if n > c.MaxBestCreatures {
+		return c.MaxCreatures + 1
+	}
+	return n
+}
+
+// minArenaSize returns the smallest width or height of the simulation area
+func (c *Config) minArenaSize() int {
+	return 4 * c.CreatureRadius
+}
+
+// Validate returns an error describing the first problem that would keep c
+// from running, or nil if c is valid
+func (c *Config) Validate() error {
+	switch {
+	case c.CreatureRadius < 1:
+		return errors.New("config: creature_radius must be at least 1")
+	case c.Width < c.minArenaSize() || c.Height < c.minArenaSize():
+		return fmt.Errorf("config: the simulation area must be at least %dx%d for creature_radius %d",
+			c.minArenaSize(), c.minArenaSize(), c.CreatureRadius)
+	case c.BorderWidth < 1:
+		return errors.New("config: border_width must be at least 1")
+	case c.ObstacleWidth <= 0:
+		return errors.New("config: obstacle_width must be positive")
+	case c.MinCreatures < 1:
+		return errors.New("config: min_creatures must be at least 1")
+	case c.MaxCreatures < c.MinCreatures:
+		return errors.New("config: max_creatures must be at least min_creatures")
+	case c.MaxBestCreatures < c.MaxCreatures:
+		return errors.New("config: max_best_creatures must be at least max_creatures")
+	case c.NumObstacles < 0:
+		return errors.New("config: num_obstacles must not be negative")
+	case c.invalidObstacleKind() != nil:
+		return fmt.Errorf("config: obstacle_kinds: %v", c.invalidObstacleKind())
+	case c.invalidObstacleMotion() != nil:
+		return fmt.Errorf("config: obstacle_motions: %v", c.invalidObstacleMotion())
+	case c.EvolutionCycleTicks < 1:
+		return errors.New("config: evolution_cycle_ticks must be at least 1")
+	case c.EpisodeTicks < 0:
+		return errors.New("config: episode_ticks must not be negative")
+	case c.MutationRate < 0 || c.MutationRate > 1:
+		return errors.New("config: mutation_rate must be from 0 to 1")
+	case c.TurnRate < 0:
+		return errors.New("config: turn_rate must not be negative")
+	case c.MoveSpeed <= 0:
+		return errors.New("config: move_speed must be positive")
+	case c.MoveSpeed >= float64(c.CreatureRadius):
+		// creatures would skip over thin obstacles and walls
+		return errors.New("config: move_speed must be less than creature_radius")
+	}
+	if err := c.validateSpawns(); err != nil {
+		return err
+	}
+	if err := c.Curriculum.validate(); err != nil {
+		return err
+	}
+	return c.Layout.validate(c)
+}
+
+// invalidObstacleKind returns an error for the first unknown kind in
+// c.ObstacleKinds, or nil if they are all known
+func (c *Config) invalidObstacleKind() error {
+	for _, k := range c.ObstacleKinds {
+		if _, err := ParseObstacleKind(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// invalidObstacleMotion returns an error for the first unknown motion in
+// c.ObstacleMotions, or nil if they are all known
+func (c *Config) invalidObstacleMotion() error {
+	for _, m := range c.ObstacleMotions {
+		if _, err := ParseObstacleMotion(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configFlags are the command line flags overriding Config fields
+var configFlags = []struct {
+	name, usage string
+	field       func(c *Config) interface{}
+}{
+	{"width", "width of the simulation area",
+		func(c *Config) interface{} { return &c.Width }},
+	{"height", "height of the simulation area",
+		func(c *Config) interface{} { return &c.Height }},
+	{"border", "thickness of the border around the simulation area",
+		func(c *Config) interface{} { return &c.BorderWidth }},
+	{"creature-radius", "radius of the creatures",
+		func(c *Config) interface{} { return &c.CreatureRadius }},
+	{"obstacle-width", "thickness of the moving obstacles",
+		func(c *Config) interface{} { return &c.ObstacleWidth }},
+	{"min-creatures", "minimum number of live creatures",
+		func(c *Config) interface{} { return &c.MinCreatures }},
+	{"max-creatures", "number of creatures to spawn up to each evolution cycle",
+		func(c *Config) interface{} { return &c.MaxCreatures }},
+	{"max-best-creatures", "size limit of the hall of fame",
+		func(c *Config) interface{} { return &c.MaxBestCreatures }},
+	{"obstacles", "number of moving obstacles",
+		func(c *Config) interface{} { return &c.NumObstacles }},
+	{"obstacle-kinds", "comma separated kinds of moving obstacles: plank, rotating_plank, circle, polygon or bar",
+		func(c *Config) interface{} { return &c.ObstacleKinds }},
+	{"obstacle-motions", "comma separated ways moving obstacles move: drift, bounce, sine, patrol or chase",
+		func(c *Config) interface{} { return &c.ObstacleMotions }},
+	{"cycle-ticks", "number of ticks between evolution cycles",
+		func(c *Config) interface{} { return &c.EvolutionCycleTicks }},
+	{"mutation-rate", "chance of each weight of a mixed genome mutating",
+		func(c *Config) interface{} { return &c.MutationRate }},
+	{"turn-rate", "turn in radians per tick at the strongest brain output",
+		func(c *Config) interface{} { return &c.TurnRate }},
+	{"move-speed", "distance moved per tick at the strongest brain output",
+		func(c *Config) interface{} { return &c.MoveSpeed }},
+}
+
+// RegisterFlags defines a flag on fs for each field of c, which is set
+// when the flags are parsed and provides the defaults
+func (c *Config) RegisterFlags(fs *flag.FlagSet) {
+	for _, f := range configFlags {
+		switch p := f.field(c).(type) {
+		case *int:
+			fs.IntVar(p, f.name, *p, f.usage)
+		case *float64:
+			fs.Float64Var(p, f.name, *p, f.usage)
+		case *[]string:
+			fs.Var((*listFlag)(p), f.name, f.usage)
+		}
+	}
+}
+
+// ApplyFlags copies the fields of the flags set on the command line of fs
+// from src, which the flags were registered with, to c
+func (c *Config) ApplyFlags(fs *flag.FlagSet, src *Config) {
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	for _, f := range configFlags {
+		if !set[f.name] {
+			continue
+		}
+		switch p := f.field(c).(type) {
+		case *int:
+			*p = *f.field(src).(*int)
+		case *float64:
+			*p = *f.field(src).(*float64)
+		case *[]string:
+			*p = append([]string(nil), *f.field(src).(*[]string)...)
+		}
+	}
+}
+
+// listFlag is a flag.Value for a comma separated list
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	*l = nil
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(c *Config)
+		err    string // a substring of the error, or "" for none
+	}{
+		{"default", func(c *Config) {}, ""},
+		{"creature radius", func(c *Config) { c.CreatureRadius = 0 },
+			"creature_radius must be at least 1"},
+		{"small area", func(c *Config) { c.Width = 23 },
+			"the simulation area must be at least 24x24 for creature_radius 6"},
+		{"border", func(c *Config) { c.BorderWidth = 0 },
+			"border_width must be at least 1"},
+		{"obstacle width", func(c *Config) { c.ObstacleWidth = 0 },
+			"obstacle_width must be positive"},
+		{"min creatures", func(c *Config) { c.MinCreatures = 0 },
+			"min_creatures must be at least 1"},
+		{"max creatures", func(c *Config) { c.MaxCreatures = c.MinCreatures - 1 },
+			"max_creatures must be at least min_creatures"},
+		{"max best creatures", func(c *Config) { c.MaxBestCreatures = c.MaxCreatures - 1 },
+			"max_best_creatures must be at least max_creatures"},
+		{"obstacles", func(c *Config) { c.NumObstacles = -1 },
+			"num_obstacles must not be negative"},
+		{"obstacle kind", func(c *Config) { c.ObstacleKinds = []string{"plank", "blob"} },
+			`obstacle_kinds: unknown obstacle kind "blob"`},
+		{"obstacle motion", func(c *Config) { c.ObstacleMotions = []string{"zigzag"} },
+			`obstacle_motions: unknown obstacle motion "zigzag"`},
+		{"cycle ticks", func(c *Config) { c.EvolutionCycleTicks = 0 },
+			"evolution_cycle_ticks must be at least 1"},
+		{"episode ticks", func(c *Config) { c.EpisodeTicks = -1 },
+			"episode_ticks must not be negative"},
+		{"mutation rate", func(c *Config) { c.MutationRate = 1.5 },
+			"mutation_rate must be from 0 to 1"},
+		{"turn rate", func(c *Config) { c.TurnRate = -1 },
+			"turn_rate must not be negative"},
+		{"move speed", func(c *Config) { c.MoveSpeed = 0 },
+			"move_speed must be positive"},
+		{"fast move speed", func(c *Config) { c.MoveSpeed = 6 },
+			"move_speed must be less than creature_radius"},
+		{"spawner motion", func(c *Config) {
+			c.Spawners = []ObstacleSpawner{{Count: 1}, {Count: 1, Motions: []string{"drift", "zigzag"}}}
+		}, `spawners[1].motions[1]: unknown obstacle motion "zigzag"`},
+		{"spawner region", func(c *Config) {
+			c.Spawners = []ObstacleSpawner{{Count: 1, Region: &Region{X: 400, Width: 10, Height: 10}}}
+		}, "spawners[0].region: the region must be inside the 405x720 simulation area"},
+		{"spawn region", func(c *Config) { c.SpawnRegions = []Region{{Width: 10}} },
+			"spawn_regions[0]: width and height must be positive"},
+		{"curriculum", func(c *Config) { c.Curriculum.AdvanceScores = []int64{10, 5} },
+			"curriculum.advance_scores[1] must not be less than the score before it"},
+		{"layout", func(c *Config) { c.Layout.Mazes = []MazeSpec{{Cols: 0, Rows: 1, Cell: 10}} },
+			"layout.mazes[0]: cols and rows must be at least 1"},
+	}
+	for _, test := range tests {
+		c := DefaultConfig()
+		test.change(&c)
+		err := c.Validate()
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		case test.err != "" && err == nil:
+			t.Errorf("%s: expected an error containing %q", test.name, test.err)
+		case test.err != "" && !strings.Contains(err.Error(), test.err):
+			t.Errorf("%s: error %q does not contain %q", test.name, err, test.err)
+		}
+	}
+}
+
+func TestApplyFlags(t *testing.T) {
+	f, err := ioutil.TempFile("", "creaturebox-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(`{"width": 500, "height": 600, "move_speed": 3, "obstacle_kinds": ["circle"]}`)
+	f.Close()
+	cfg, err := LoadConfig(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flagConfig := DefaultConfig()
+	flagConfig.RegisterFlags(fs)
+	err = fs.Parse([]string{"-height", "800", "-mutation-rate", "0.25",
+		"-obstacle-motions", "bounce, sine"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.ApplyFlags(fs, &flagConfig)
+
+	want := DefaultConfig()
+	want.Width = 500                                  // from the file
+	want.Height = 800                                 // the flag overrides the file
+	want.MoveSpeed = 3                                // from the file
+	want.ObstacleKinds = []string{"circle"}           // from the file
+	want.MutationRate = 0.25                          // from a flag
+	want.ObstacleMotions = []string{"bounce", "sine"} // from a flag
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("got config %+v, expected %+v", cfg, want)
+	}
+	// the flag lists must not be shared with the applied config
+	flagConfig.ObstacleMotions[0] = "chase"
+	if cfg.ObstacleMotions[0] != "bounce" {
+		t.Errorf("applied obstacle_motions share memory with the flags")
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Curriculum raises the difficulty of a simulation as the creatures improve
+// by adding obstacles and making them faster and longer, and lowers it again
+// if the population collapses. The level is checked each evolution cycle.
+type Curriculum struct {
+	// The median hall of fame score needed to advance from each level to
+	// the next, the number of scores is the highest level. The curriculum
+	// is disabled if there are none.
+	AdvanceScores []int64 `json:"advance_scores,omitempty"`
+	// The number of deaths by the border, obstacles and walls in an
+	// evolution cycle at which the level is lowered, zero to never lower it
+	CollapseDeaths int `json:"collapse_deaths,omitempty"`
+	// The number of evolution cycles after a change of level before the
+	// level may change again
+	CooldownCycles int `json:"cooldown_cycles,omitempty"`
+	// The increase per level of the number of obstacles from each spawner
+	// and of the spawners' speed and length multipliers
+	CountStep  int     `json:"count_step,omitempty"`
+	SpeedStep  float64 `json:"speed_step,omitempty"`
+	LengthStep float64 `json:"length_step,omitempty"`
+}
+
+// validate returns an error naming the first invalid field of c, or nil if
+// c is valid
+func (c *Curriculum) validate() error {
+	for i, score := range c.AdvanceScores {
+		switch {
+		case score < 0:
+			return fmt.Errorf("config: curriculum.advance_scores[%d] must not be negative", i)
+		case i > 0 && score < c.AdvanceScores[i-1]:
+			return fmt.Errorf("config: curriculum.advance_scores[%d] must not be less than the score before it", i)
+		}
+	}
+	switch {
+	case c.CollapseDeaths < 0:
+		return errors.New("config: curriculum.collapse_deaths must not be negative")
+	case c.CooldownCycles < 0:
+		return errors.New("config: curriculum.cooldown_cycles must not be negative")
+	case c.CountStep < 0:
+		return errors.New("config: curriculum.count_step must not be negative")
+	case c.SpeedStep < 0:
+		return errors.New("config: curriculum.speed_step must not be negative")
+	case c.LengthStep < 0:
+		return errors.New("config: curriculum.length_step must not be negative")
+	}
+	return nil
+}
+
+// Level returns the current difficulty level of the curriculum, which is
+// always zero without one
+func (s *Sim) Level() int {
+	return s.level
+}
+
+// hallOfFameMedian returns the median score in the hall of fame, which must
+// be sorted
+func (s *Sim) hallOfFameMedian() float64 {
+	n := len(s.bestCreatures)
+	switch {
+	case n == 0:
+		return 0
+	case n%2 == 1:
+		return float64(s.bestCreatures[n/2].score)
+	}
+	return float64(s.bestCreatures[n/2-1].score+s.bestCreatures[n/2].score) / 2
+}
+
+// updateLevel advances or lowers the difficulty level at the start of an
+// evolution cycle according to the curriculum. The level only advances after
+// a cycle without a collapse.
+func (s *Sim) updateLevel() {
+	c := &s.cfg.Curriculum
+	deaths := s.cycleDeaths
+	s.cycleDeaths = 0
+	if len(c.AdvanceScores) == 0 {
+		return
+	}
+	if s.levelCooldown > 0 {
+		s.levelCooldown--
+		return
+	}
+	median := s.hallOfFameMedian()
+	if c.CollapseDeaths > 0 && deaths >= c.CollapseDeaths {
+		if s.level > 0 {
+			s.collapseMedian = median
+			s.setLevel(s.level - 1)
+		}
+		return
+	}
+	// the hall of fame keeps the scores from before a collapse, so the
+	// median must improve on them before advancing again
+	if s.level < len(c.AdvanceScores) && median >= float64(c.AdvanceScores[s.level]) &&
+		median > s.collapseMedian {
+		s.setLevel(s.level + 1)
+	}
+}
+
+// setLevel sets the difficulty level, changing the obstacle spawners from
+// their configured settings by the curriculum's steps for each level.
+// Obstacles over the new count of a spawner are removed, newest first.
+func (s *Sim) setLevel(level int) {
+	c := &s.cfg.Curriculum
+	s.level = level
+	s.levelCooldown = c.CooldownCycles
+	base := s.cfg.obstacleSpawners()
+	for i := range s.spawners {
+		sp := &s.spawners[i]
+		sp.Count = base[i].Count + level*c.CountStep
+		speed, length := base[i].Speed, base[i].Length
+		if speed == 0 {
+			speed = 1
+		}
+		if length == 0 {
+			length = 1
+		}
+		sp.Speed = speed * (1 + float64(level)*c.SpeedStep)
+		sp.Length = length * (1 + float64(level)*c.LengthStep)
+		n := 0
+		for j := range s.obstacles {
+			if s.obstacles[j].spawner == i {
+				n++
+			}
+		}
+		for j := len(s.obstacles) - 1; j >= 0 && n > sp.Count; j-- {
+			if s.obstacles[j].spawner == i {
+				s.obstacles = append(s.obstacles[:j], s.obstacles[j+1:]...)
+				n--
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import "testing"
+
+// TestUpdateLevel drives updateLevel with a hall of fame holding a single
+// score, so the score is the median
+func TestUpdateLevel(t *testing.T) {
+	steps := []struct {
+		score  int64
+		deaths int
+		level  int
+	}{
+		// deaths during a collapse do not fall through to advancing
+		{score: 150, deaths: 10, level: 0},
+		{score: 150, deaths: 0, level: 1},
+		{score: 150, deaths: 0, level: 1},
+		{score: 200, deaths: 9, level: 2},
+		{score: 200, deaths: 10, level: 1},
+		// the median has not risen since the collapse
+		{score: 200, deaths: 0, level: 1},
+		{score: 201, deaths: 10, level: 0},
+		{score: 201, deaths: 0, level: 0},
+		{score: 202, deaths: 0, level: 1},
+		{score: 202, deaths: 0, level: 2},
+		{score: 999, deaths: 0, level: 2},
+	}
+	cfg := DefaultConfig()
+	cfg.Curriculum = Curriculum{
+		AdvanceScores:  []int64{100, 200},
+		CollapseDeaths: 10,
+		CountStep:      1,
+	}
+	s := NewSim(cfg, 1)
+	for i, step := range steps {
+		s.bestCreatures = TopCreatures{{score: step.score}}
+		s.cycleDeaths = step.deaths
+		s.updateLevel()
+		if s.Level() != step.level {
+			t.Fatalf("step %d: level %d, want %d", i, s.Level(), step.level)
+		}
+		if want := cfg.NumObstacles + step.level; s.spawners[0].Count != want {
+			t.Fatalf("step %d: spawner count %d, want %d", i, s.spawners[0].Count, want)
+		}
+	}
+}
+
+func TestUpdateLevelCooldown(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Curriculum = Curriculum{
+		AdvanceScores:  []int64{0, 0, 0},
+		CooldownCycles: 2,
+	}
+	s := NewSim(cfg, 1)
+	var levels []int
+	for i := 0; i < 7; i++ {
+		s.updateLevel()
+		levels = append(levels, s.Level())
+	}
+	want := []int{1, 1, 1, 2, 2, 2, 3}
+	for i := range want {
+		if levels[i] != want[i] {
+			t.Fatalf("levels %v, want %v", levels, want)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+)
+
+// DeathRecord describes the death of a single creature
+type DeathRecord struct {
+	Tick  int        `json:"tick"`  // The tick the creature died on
+	Cause DeathCause `json:"cause"` // What the creature touched
+	// The id of the obstacle nearest the creature if Cause is
+	// DeathByObstacle, otherwise 0
+	Obstacle int `json:"obstacle,omitempty"`
+	// The id of the wall nearest the creature if Cause is DeathByWall,
+	// otherwise 0
+	Wall int `json:"wall,omitempty"`
+	// The position within the simulation area and heading of the creature
+	X     float64 `json:"x"`
+	Y     float64 `json:"y"`
+	Angle float64 `json:"angle"`
+	// The last brain outputs of the creature
+	Turn float64 `json:"turn"`
+	Move float64 `json:"move"`
+	// The number of ticks the creature was alive for and its score
+	Age   int   `json:"age"`
+	Score int64 `json:"score"`
+}
+
+// MarshalText implements encoding.TextMarshaler so that causes are
+// logged by name
+func (d DeathCause) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// newDeathRecord returns a DeathRecord for the creature c killed by cause
+func (s *Sim) newDeathRecord(c *Creature, cause DeathCause) DeathRecord {
+	d := DeathRecord{
+		Tick:  s.tickCounter,
+		Cause: cause,
+		X:     c.x,
+		Y:     c.y,
+		Angle: math.Mod(c.angle, 2*math.Pi),
+		Turn:  c.turn,
+		Move:  c.move,
+		Age:   s.tickCounter - c.born,
+		Score: c.score,
+	}
+	if d.Angle < 0 {
+		d.Angle += 2 * math.Pi
+	}
+	switch cause {
+	case DeathByObstacle:
+		d.Obstacle, _ = s.nearestObstacle(c.x, c.y)
+	case DeathByWall:
+		d.Wall, _ = s.nearestWall(c.x, c.y)
+	}
+	return d
+}
+
+// collisionCause returns whether a creature at (x, y) that collided with
+// something other than the border most likely touched an obstacle or a wall
+func (s *Sim) collisionCause(x, y float64) DeathCause {
+	_, obstacleDist := s.nearestObstacle(x, y)
+	_, wallDist := s.nearestWall(x, y)
+	if wallDist < obstacleDist {
+		return DeathByWall
+	}
+	return DeathByObstacle
+}
+
+// nearestObstacle returns the id of and distance to the obstacle closest
+// to (x, y) or 0 if there are no obstacles
+func (s *Sim) nearestObstacle(x, y float64) (id int, dist float64) {
+	best := math.MaxFloat64
+	for i := range s.obstacles {
+		o := s.obstacles[i].state()
+		if dist := o.Dist(x, y); dist < best {
+			best = dist
+			id = o.ID
+		}
+	}
+	return id, best
+}
+
+// segmentDist returns the distance from (x,y) to the line segment
+// from (x1,y1) to (x2,y2)
+func segmentDist(x, y, x1, y1, x2, y2 float64) float64 {
+	dx := x2 - x1
+	dy := y2 - y1
+	lenSq := dx*dx + dy*dy
+	if lenSq == 0 {
+		return xyDist(x, y, x1, y1)
+	}
+	// project (x,y) onto the segment clamping to the end points
+	t := ((x-x1)*dx + (y-y1)*dy) / lenSq
+	t = math.Max(0, math.Min(1, t))
+	return xyDist(x, y, x1+t*dx, y1+t*dy)
+}
+
+// DeathLog is an Observer that writes a DeathRecord to a stream as JSON for
+// each creature death, one record per line
+type DeathLog struct {
+	BaseObserver
+	enc *json.Encoder
+	err error
+}
+
+// NewDeathLog returns a DeathLog writing to w
+func NewDeathLog(w io.Writer) *DeathLog {
+	return &DeathLog{
+		enc: json.NewEncoder(w),
+	}
+}
+
+// OnDeath implements Observer by logging d
+func (l *DeathLog) OnDeath(s *Sim, c *Creature, d DeathRecord) {
+	if l.err != nil {
+		return
+	}
+	l.err = l.enc.Encode(&d)
+}
+
+// Err returns the first error encountered while writing the log, if any
+func (l *DeathLog) Err() error {
+	return l.err
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewDeathRecord(t *testing.T) {
+	s := NewSim(DefaultConfig(), 1)
+	s.obstacles = []Obstacle{
+		{id: 7, kind: ObstacleCircle, x: 100, y: 100, length: 10},
+		{id: 8, kind: ObstacleCircle, x: 10, y: 10, length: 4},
+	}
+	s.walls = nil
+	wall := s.AddWall(90, 120, 110, 120)
+	s.tickCounter = 25
+	c := &Creature{x: 101, y: 104, angle: -math.Pi / 2, turn: 0.5, move: -1, born: 5, score: 20}
+	for _, tc := range []struct {
+		cause DeathCause
+		want  DeathRecord
+	}{
+		{DeathByObstacle, DeathRecord{Tick: 25, Cause: DeathByObstacle, Obstacle: 7,
+			X: 101, Y: 104, Turn: 0.5, Move: -1, Age: 20, Score: 20}},
+		{DeathByWall, DeathRecord{Tick: 25, Cause: DeathByWall, Wall: wall,
+			X: 101, Y: 104, Turn: 0.5, Move: -1, Age: 20, Score: 20}},
+		{DeathByBorder, DeathRecord{Tick: 25, Cause: DeathByBorder,
+			X: 101, Y: 104, Turn: 0.5, Move: -1, Age: 20, Score: 20}},
+	} {
+		d := s.newDeathRecord(c, tc.cause)
+		// the heading is kept in [0, 2*Pi)
+		if math.Abs(d.Angle-3*math.Pi/2) > 1e-9 {
+			t.Errorf("%v: angle %v, want %v", tc.cause, d.Angle, 3*math.Pi/2)
+		}
+		d.Angle = 0
+		if d != tc.want {
+			t.Errorf("%v: record %+v, want %+v", tc.cause, d, tc.want)
+		}
+	}
+}
+
+func TestDeathLog(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewDeathLog(&buf)
+	for _, d := range []DeathRecord{
+		{Tick: 12, Cause: DeathByObstacle, Obstacle: 3, X: 1.5, Y: 2, Angle: 0.25,
+			Turn: -0.5, Move: 1, Age: 10, Score: 10},
+		{Tick: 13, Cause: DeathByWall, Wall: 2, X: 4, Y: 5, Age: 1, Score: 1},
+		{Tick: 13, Cause: DeathByBorder},
+	} {
+		l.OnDeath(nil, nil, d)
+	}
+	if err := l.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"tick":12,"cause":"obstacle","obstacle":3,"x":1.5,"y":2,"angle":0.25,"turn":-0.5,"move":1,"age":10,"score":10}
+{"tick":13,"cause":"wall","wall":2,"x":4,"y":5,"angle":0,"turn":0,"move":0,"age":1,"score":1}
+{"tick":13,"cause":"border","x":0,"y":0,"angle":0,"turn":0,"move":0,"age":0,"score":0}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("logged:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+// failingWriter counts the writes to it, which all fail
+type failingWriter struct {
+	writes int
+}
+
+var errWriteFailed = errors.New("write failed")
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	return 0, errWriteFailed
+}
+
+func TestDeathLogError(t *testing.T) {
+	w := &failingWriter{}
+	l := NewDeathLog(w)
+	l.OnDeath(nil, nil, DeathRecord{Tick: 1})
+	l.OnDeath(nil, nil, DeathRecord{Tick: 2})
+	if err := l.Err(); err != errWriteFailed {
+		t.Errorf("Err() = %v, want %v", err, errWriteFailed)
+	}
+	// the log stops writing after the first error
+	if w.writes != 1 {
+		t.Errorf("%d writes, want 1", w.writes)
+	}
+}
+
+func TestCollisionCause(t *testing.T) {
+	obstacle := Obstacle{id: 1, kind: ObstacleCircle, x: 100, y: 100, length: 20}
+	for _, tc := range []struct {
+		name      string
+		obstacles []Obstacle
+		wall      bool
+		x, y      float64
+		want      DeathCause
+	}{
+		{"obstacle only", []Obstacle{obstacle}, false, 105, 100, DeathByObstacle},
+		{"wall only", nil, true, 40, 105, DeathByWall},
+		{"neither", nil, false, 40, 105, DeathByObstacle},
+		{"inside the obstacle", []Obstacle{obstacle}, true, 100, 100, DeathByObstacle},
+		{"inside the wall", []Obstacle{obstacle}, true, 40, 100, DeathByWall},
+		{"nearer the obstacle", []Obstacle{obstacle}, true, 111, 100, DeathByObstacle},
+		{"nearer the wall", []Obstacle{obstacle}, true, 40, 103, DeathByWall},
+	} {
+		s := NewSim(DefaultConfig(), 1)
+		s.obstacles = tc.obstacles
+		s.walls = nil
+		if tc.wall {
+			s.AddWall(20, 100, 60, 100)
+		}
+		if got := s.collisionCause(tc.x, tc.y); got != tc.want {
+			t.Errorf("%s: collisionCause(%v, %v) = %v, want %v", tc.name, tc.x, tc.y, got, tc.want)
+		}
+	}
+}
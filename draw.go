@@ -0,0 +1,102 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/llgcode/draw2d"
+	"github.com/llgcode/draw2d/draw2dkit"
+)
+
+// DrawWorld draws the world state w, the border, obstacles, walls and creatures,
+// to gc in frame coordinates. Scale gc's transform to draw it at other
+// resolutions, see WorldState.FrameBounds for the frame size.
+func DrawWorld(gc draw2d.GraphicContext, w *WorldState) {
+	drawBorder(gc, w)
+	drawObstacles(gc, w)
+	drawWalls(gc, w)
+	drawCreatures(gc, w)
+}
+
+// drawBorder draws the simulation border and clears the area inside it to
+// the background color
+func drawBorder(gc draw2d.GraphicContext, w *WorldState) {
+	bounds := w.FrameBounds()
+	frameWidthf := float64(bounds.Dx())
+	frameHeightf := float64(bounds.Dy())
+	borderWidthf := float64(w.BorderWidth)
+	gc.SetFillColor(Black)
+	// top
+	draw2dkit.Rectangle(gc, 0, 0, frameWidthf, borderWidthf)
+	gc.Fill()
+	// left
+	draw2dkit.Rectangle(gc, 0, borderWidthf, borderWidthf, frameHeightf)
+	gc.Fill()
+	// right
+	draw2dkit.Rectangle(gc, frameWidthf-borderWidthf, borderWidthf,
+		frameWidthf, frameHeightf)
+	gc.Fill()
+	// bottom
+	draw2dkit.Rectangle(gc, borderWidthf, frameHeightf-borderWidthf,
+		frameWidthf-borderWidthf, frameHeightf)
+	gc.Fill()
+
+	// clear actual drawing area to BG color
+	draw2dkit.Rectangle(gc, borderWidthf, borderWidthf,
+		frameWidthf-borderWidthf, frameHeightf-borderWidthf)
+	gc.SetFillColor(BGColor)
+	gc.Fill()
+}
+
+// drawObstacles draws the moving obstacles
+func drawObstacles(gc draw2d.GraphicContext, w *WorldState) {
+	borderWidthf := float64(w.BorderWidth)
+	gc.SetFillColor(color.Black)
+	gc.SetLineWidth(w.ObstacleWidth)
+	for i := range w.Obstacles {
+		if k := w.Obstacles[i].Kind; k == ObstacleCircle || k == ObstaclePolygon {
+			drawObstacleShape(gc, &w.Obstacles[i], borderWidthf)
+			continue
+		}
+		x := w.Obstacles[i].X
+		y := w.Obstacles[i].Y
+		l := w.Obstacles[i].Length
+		a := w.Obstacles[i].Angle
+		gc.MoveTo(borderWidthf+x, borderWidthf+y)
+		gc.LineTo(borderWidthf+x+math.Cos(a)*l, borderWidthf+y+math.Sin(a)*l)
+		gc.FillStroke()
+		gc.Close()
+	}
+}
+
+// drawCreatures draws the creatures with a dot showing their heading
+func drawCreatures(gc draw2d.GraphicContext, w *WorldState) {
+	borderWidthf := float64(w.BorderWidth)
+	for i := range w.Creatures {
+		c := &w.Creatures[i]
+		gc.SetFillColor(c.Color)
+		draw2dkit.Circle(gc, borderWidthf+c.X, borderWidthf+c.Y, w.CreatureRadius)
+		gc.Fill()
+		ax := math.Cos(c.Angle)
+		ay := math.Sin(c.Angle)
+		gc.SetFillColor(color.White)
+		draw2dkit.Circle(gc, borderWidthf+c.X+ax*3, borderWidthf+c.Y+ay*3, 2)
+		gc.Fill()
+	}
+}
@@ -0,0 +1,373 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FrameWriter writes a sequence of frames to an animation or image files
+type FrameWriter interface {
+	// WriteFrame adds img as the next frame, img may be reused by the
+	// caller after WriteFrame returns
+	WriteFrame(img image.Image) error
+	// Close finishes writing the frames
+	Close() error
+}
+
+// errNoFrames is returned when closing an animation without any frames,
+// which would not be a valid file
+var errNoFrames = errors.New("export: no frames were written")
+
+// NewFrameWriter creates a FrameWriter for format at path, where format is
+// one of "gif", "apng", or "png" for a numbered sequence of png files in the
+// directory path. If format is empty it is chosen from the path extension.
+// Frames are played back at fps frames per second in animations.
+func NewFrameWriter(path, format string, fps int) (FrameWriter, error) {
+	if fps <= 0 {
+		return nil, errors.New("export: fps must be positive")
+	}
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".gif":
+			format = "gif"
+		case ".png", ".apng":
+			format = "apng"
+		default:
+			format = "png"
+		}
+	}
+	switch format {
+	case "gif":
+		f, err := os.Create(path)
+		if err != nil {
+			return nil, err
+		}
+		return &gifWriter{f: f, delay: gifDelay(fps)}, nil
+	case "apng":
+		f, err := os.Create(path)
+		if err != nil {
+			return nil, err
+		}
+		return &apngWriter{f: f, fps: fps}, nil
+	case "png":
+		if err := os.MkdirAll(path, 0755); err != nil {
+			return nil, err
+		}
+		return &pngSequenceWriter{dir: path}, nil
+	}
+	return nil, fmt.Errorf("export: unknown format %q", format)
+}
+
+// gifDelay returns the gif frame delay in hundredths of a second closest to
+// fps frames per second. Delays are at least 2, since browsers play shorter
+// delays at 10 frames per second, which limits gifs to 50 frames per second.
+func gifDelay(fps int) int {
+	delay := int(math.Floor(100/float64(fps) + 0.5))
+	if delay < 2 {
+		delay = 2
+	}
+	return delay
+}
+
+// FrameExporter is an Observer that draws every stride-th tick with a
+// RasterRenderer and writes the frame to a FrameWriter
+type FrameExporter struct {
+	BaseObserver
+	w        FrameWriter
+	renderer *RasterRenderer
+	stride   int
+	err      error
+}
+
+// NewFrameExporter returns a FrameExporter writing every stride-th frame
+// drawn with r to w, it must be registered with Sim.AddObserver
+func NewFrameExporter(w FrameWriter, r *RasterRenderer, stride int) *FrameExporter {
+	if stride < 1 {
+		stride = 1
+	}
+	return &FrameExporter{
+		w:        w,
+		renderer: r,
+		stride:   stride,
+	}
+}
+
+// OnTick implements Observer by drawing and writing the current frame
+func (e *FrameExporter) OnTick(s *Sim) {
+	if e.err != nil || (s.tickCounter-1)%e.stride != 0 {
+		return
+	}
+	e.renderer.Render(s.State())
+	e.err = e.w.WriteFrame(e.renderer.Frame)
+}
+
+// Close finishes the export and returns the first error encountered
+func (e *FrameExporter) Close() error {
+	if err := e.w.Close(); e.err == nil {
+		e.err = err
+	}
+	return e.err
+}
+
+// gifWriter writes an animated gif as the frames arrive, quantizing them to
+// the Plan 9 palette. Each frame is encoded as a gif of its own and its blocks
+// are copied after the header of the first, so only one frame is kept in
+// memory however long the animation is.
+type gifWriter struct {
+	f      *os.File
+	delay  int
+	p      *image.Paletted
+	buf    bytes.Buffer
+	frames int
+	err    error
+}
+
+// gifLoopForever is the application extension block making a gif loop
+var gifLoopForever = []byte("\x21\xff\x0bNETSCAPE2.0\x03\x01\x00\x00\x00")
+
+// write writes b unless a previous write failed
+func (g *gifWriter) write(b []byte) {
+	if g.err == nil {
+		_, g.err = g.f.Write(b)
+	}
+}
+
+func (g *gifWriter) WriteFrame(img image.Image) error {
+	if g.err != nil {
+		return g.err
+	}
+	if g.p == nil || g.p.Rect != img.Bounds() {
+		g.p = image.NewPaletted(img.Bounds(), palette.Plan9)
+	}
+	draw.Draw(g.p, g.p.Rect, img, img.Bounds().Min, draw.Src)
+	g.buf.Reset()
+	err := gif.EncodeAll(&g.buf, &gif.GIF{
+		Image: []*image.Paletted{g.p},
+		Delay: []int{g.delay},
+		// every frame shares the global color table of the first
+		Config: image.Config{
+			ColorModel: color.Palette(palette.Plan9),
+			Width:      g.p.Rect.Dx(),
+			Height:     g.p.Rect.Dy(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	// the header and logical screen descriptor are followed by the global
+	// color table, then the blocks of the frame and the trailer
+	b := g.buf.Bytes()
+	header := 13
+	if b[10]&0x80 != 0 {
+		header += 3 << (b[10]&7 + 1)
+	}
+	if g.frames == 0 {
+		g.write(b[:header])
+		g.write(gifLoopForever)
+	}
+	g.write(b[header : len(b)-1])
+	g.frames++
+	return g.err
+}
+
+func (g *gifWriter) Close() error {
+	if g.err == nil && g.frames == 0 {
+		g.err = errNoFrames
+	}
+	// the trailer
+	g.write([]byte{0x3b})
+	if err := g.f.Close(); g.err == nil {
+		g.err = err
+	}
+	return g.err
+}
+
+// pngSequenceWriter writes each frame to a numbered png file in dir
+type pngSequenceWriter struct {
+	dir string
+	n   int
+}
+
+func (p *pngSequenceWriter) WriteFrame(img image.Image) error {
+	f, err := os.Create(filepath.Join(p.dir, fmt.Sprintf("frame_%06d.png", p.n)))
+	if err != nil {
+		return err
+	}
+	p.n++
+	err = png.Encode(f, img)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func (p *pngSequenceWriter) Close() error {
+	return nil
+}
+
+// apngWriter writes an animated png as the frames arrive. The number of
+// frames is patched into the animation control chunk when closed.
+// See: https://wiki.mozilla.org/APNG_Specification
+type apngWriter struct {
+	f        *os.File
+	fps      int
+	enc      png.Encoder
+	buf      bytes.Buffer
+	frames   uint32
+	seq      uint32 // the sequence number for fcTL and fdAT chunks
+	actlData int64  // the offset of the acTL chunk data
+	err      error
+}
+
+// writeChunk writes a png chunk with the chunk type typ and data
+func (a *apngWriter) writeChunk(typ string, data []byte) {
+	if a.err != nil {
+		return
+	}
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[:4], uint32(len(data)))
+	copy(header[4:], typ)
+	crc := crc32.NewIEEE()
+	crc.Write(header[4:])
+	crc.Write(data)
+	var footer [4]byte
+	binary.BigEndian.PutUint32(footer[:], crc.Sum32())
+	for _, b := range [][]byte{header[:], data, footer[:]} {
+		if _, a.err = a.f.Write(b); a.err != nil {
+			return
+		}
+	}
+}
+
+// pngChunks splits an encoded png into its chunks, calling fn for each
+func pngChunks(b []byte, fn func(typ string, data []byte)) error {
+	if len(b) < 8 {
+		return errors.New("export: png too short")
+	}
+	b = b[8:]
+	for len(b) >= 12 {
+		n := int(binary.BigEndian.Uint32(b[:4]))
+		if len(b) < 12+n {
+			break
+		}
+		fn(string(b[4:8]), b[8:8+n])
+		b = b[12+n:]
+	}
+	if len(b) != 0 {
+		return errors.New("export: truncated png chunk")
+	}
+	return nil
+}
+
+func (a *apngWriter) WriteFrame(img image.Image) error {
+	if a.err != nil {
+		return a.err
+	}
+	a.buf.Reset()
+	if err := a.enc.Encode(&a.buf, img); err != nil {
+		return err
+	}
+	first := a.frames == 0
+	var ihdr, idat []byte
+	err := pngChunks(a.buf.Bytes(), func(typ string, data []byte) {
+		switch typ {
+		case "IHDR":
+			ihdr = data
+		case "IDAT":
+			idat = append(idat, data...)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if first {
+		if _, a.err = a.f.Write([]byte("\x89PNG\r\n\x1a\n")); a.err != nil {
+			return a.err
+		}
+		a.writeChunk("IHDR", ihdr)
+		// remember where the frame count goes, after the signature, the
+		// IHDR chunk and the length and type of the acTL chunk
+		a.actlData = 8 + 12 + int64(len(ihdr)) + 8
+		// frame count to be patched later, loop forever
+		a.writeChunk("acTL", make([]byte, 8))
+	}
+	bounds := img.Bounds()
+	fctl := make([]byte, 26)
+	binary.BigEndian.PutUint32(fctl[0:], a.seq)
+	binary.BigEndian.PutUint32(fctl[4:], uint32(bounds.Dx()))
+	binary.BigEndian.PutUint32(fctl[8:], uint32(bounds.Dy()))
+	// x and y offset are zero
+	binary.BigEndian.PutUint16(fctl[20:], 1)
+	binary.BigEndian.PutUint16(fctl[22:], uint16(a.fps))
+	// dispose and blend ops are zero, APNG_DISPOSE_OP_NONE and
+	// APNG_BLEND_OP_SOURCE
+	a.writeChunk("fcTL", fctl)
+	a.seq++
+	if first {
+		a.writeChunk("IDAT", idat)
+	} else {
+		fdat := make([]byte, 4+len(idat))
+		binary.BigEndian.PutUint32(fdat, a.seq)
+		copy(fdat[4:], idat)
+		a.writeChunk("fdAT", fdat)
+		a.seq++
+	}
+	a.frames++
+	return a.err
+}
+
+func (a *apngWriter) Close() error {
+	if a.err == nil && a.frames == 0 {
+		a.err = errNoFrames
+	}
+	if a.err == nil {
+		a.writeChunk("IEND", nil)
+		// patch the frame count and crc of the acTL chunk
+		actl := make([]byte, 8)
+		binary.BigEndian.PutUint32(actl, a.frames)
+		crc := crc32.NewIEEE()
+		crc.Write([]byte("acTL"))
+		crc.Write(actl)
+		footer := make([]byte, 4)
+		binary.BigEndian.PutUint32(footer, crc.Sum32())
+		if a.err == nil {
+			_, a.err = a.f.WriteAt(actl, a.actlData)
+		}
+		if a.err == nil {
+			_, a.err = a.f.WriteAt(footer, a.actlData+8)
+		}
+	}
+	if err := a.f.Close(); a.err == nil {
+		a.err = err
+	}
+	return a.err
+}
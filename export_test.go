@@ -0,0 +1,196 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGIFDelay(t *testing.T) {
+	tests := []struct {
+		fps, delay int
+	}{
+		{1, 100},
+		{3, 33},
+		{6, 17},
+		{24, 4},
+		{30, 3},
+		{40, 3},
+		{50, 2},
+		{60, 2},
+		{1000, 2},
+	}
+	for _, tt := range tests {
+		if got := gifDelay(tt.fps); got != tt.delay {
+			t.Errorf("gifDelay(%d) = %d, want %d", tt.fps, got, tt.delay)
+		}
+	}
+}
+
+func TestGIFWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "creaturebox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := writeFrames(t, dir, "gif", 3)
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	anim, err := gif.DecodeAll(f)
+	if err != nil {
+		t.Fatalf("decoding gif: %v", err)
+	}
+	if len(anim.Image) != 3 {
+		t.Fatalf("gif has %d frames, want 3", len(anim.Image))
+	}
+	if anim.LoopCount != 0 {
+		t.Errorf("gif loop count %d, want 0 to loop forever", anim.LoopCount)
+	}
+	for i, d := range anim.Delay {
+		if d != 4 {
+			t.Errorf("frame %d has delay %d, want 4", i, d)
+		}
+	}
+	// each frame has one more white pixel on the diagonal
+	for i, frame := range anim.Image {
+		for j := 0; j < 3; j++ {
+			r, _, _, _ := frame.At(j, j).RGBA()
+			if white := r == 0xffff; white != (j <= i) {
+				t.Errorf("frame %d pixel (%d, %d) white is %v", i, j, j, white)
+			}
+		}
+	}
+}
+
+// writeFrames writes n frames of a small image in format at 25 frames per
+// second to a new file in dir and returns its path. Each frame has one more
+// white pixel on the diagonal.
+func writeFrames(t *testing.T, dir, format string, n int) string {
+	path := filepath.Join(dir, fmt.Sprintf("clip%d.%s", n, format))
+	w, err := NewFrameWriter(path, format, 25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := image.NewRGBA(image.Rect(0, 0, 8, 6))
+	for i := 0; i < n; i++ {
+		img.Set(i, i, color.White)
+		if err := w.WriteFrame(img); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestAPNGWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "creaturebox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	b, err := ioutil.ReadFile(writeFrames(t, dir, "apng", 4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(b, []byte("\x89PNG\r\n\x1a\n")) {
+		t.Fatalf("apng starts with %q", b[:8])
+	}
+	var types []string
+	var actlFrames uint32
+	fctls := 0
+	seq := uint32(0)
+	for rest := b[8:]; len(rest) > 0; {
+		if len(rest) < 12 {
+			t.Fatalf("truncated chunk %q", rest)
+		}
+		n := binary.BigEndian.Uint32(rest)
+		if len(rest) < 12+int(n) {
+			t.Fatalf("truncated %s chunk", rest[4:8])
+		}
+		typ, data := string(rest[4:8]), rest[8:8+n]
+		if crc := binary.BigEndian.Uint32(rest[8+n:]); crc != crc32.ChecksumIEEE(rest[4:8+n]) {
+			t.Errorf("%s chunk %d has a bad crc", typ, len(types))
+		}
+		rest = rest[12+n:]
+		types = append(types, typ)
+		switch typ {
+		case "acTL":
+			actlFrames = binary.BigEndian.Uint32(data)
+		case "fcTL", "fdAT":
+			if got := binary.BigEndian.Uint32(data); got != seq {
+				t.Errorf("%s chunk has sequence number %d, want %d", typ, got, seq)
+			}
+			seq++
+			if typ == "fcTL" {
+				fctls++
+			}
+		}
+	}
+	want := []string{"IHDR", "acTL", "fcTL", "IDAT", "fcTL", "fdAT", "fcTL", "fdAT",
+		"fcTL", "fdAT", "IEND"}
+	if !reflect.DeepEqual(types, want) {
+		t.Errorf("got chunks %v, want %v", types, want)
+	}
+	if actlFrames != 4 || fctls != 4 {
+		t.Errorf("acTL has %d frames and there are %d fcTL chunks, want 4", actlFrames, fctls)
+	}
+	// viewers without apng support show the first frame
+	img, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("decoding apng as png: %v", err)
+	}
+	if r, _, _, _ := img.At(0, 0).RGBA(); r != 0xffff {
+		t.Errorf("the default image is not the first frame")
+	}
+	if r, _, _, _ := img.At(1, 1).RGBA(); r == 0xffff {
+		t.Errorf("the default image is not the first frame")
+	}
+}
+
+func TestFrameWriterNoFrames(t *testing.T) {
+	dir, err := ioutil.TempDir("", "creaturebox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, format := range []string{"gif", "apng"} {
+		w, err := NewFrameWriter(filepath.Join(dir, "empty."+format), format, 25)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != errNoFrames {
+			t.Errorf("%s: Close() without frames = %v, want %v", format, err, errNoFrames)
+		}
+	}
+}
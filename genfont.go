@@ -0,0 +1,77 @@
+//go:build ignore
+// +build ignore
+
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// genfont writes textfont.go, which embeds the vendored Luxi Mono font so
+// that text can be drawn on platforms without access to draw2d's font
+// folder, such as Android. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+const fontDir = "Godeps/_workspace/src/github.com/llgcode/draw2d/resource/font/"
+
+func main() {
+	ttf, err := ioutil.ReadFile(fontDir + "luximr.ttf")
+	if err != nil {
+		log.Fatal(err)
+	}
+	notice, err := ioutil.ReadFile(fontDir + "COPYING")
+	if err != nil {
+		log.Fatal(err)
+	}
+	header, err := ioutil.ReadFile("draw.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var b bytes.Buffer
+	// the license header comment ends with the first */
+	b.Write(header[:bytes.Index(header, []byte("*/\n"))+3])
+	b.WriteString("\n")
+	b.WriteString("// Code generated by genfont.go; DO NOT EDIT.\n\n")
+	for _, line := range strings.Split(strings.TrimSpace(string(notice)), "\n") {
+		b.WriteString(strings.TrimRight("// "+line, " ") + "\n")
+	}
+	b.WriteString("\npackage main\n\n")
+	b.WriteString("// luxiMonoTTF is luximr.ttf from the draw2d font folder\n")
+	b.WriteString("const luxiMonoTTF = \"\" +\n")
+	for i := 0; i < len(ttf); i += 32 {
+		end := i + 32
+		if end > len(ttf) {
+			end = len(ttf)
+		}
+		b.WriteString("\t\"")
+		for _, c := range ttf[i:end] {
+			fmt.Fprintf(&b, "\\x%02x", c)
+		}
+		b.WriteString("\"")
+		if end < len(ttf) {
+			b.WriteString(" +")
+		}
+		b.WriteString("\n")
+	}
+	if err := ioutil.WriteFile("textfont.go", b.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
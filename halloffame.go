@@ -0,0 +1,201 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// hallOfFameVersion is the version of the hall of fame file format
+const hallOfFameVersion = 1
+
+// Genome is a set of Brain weights saved to a hall of fame file
+type Genome struct {
+	Lineage
+	Score   int64     `json:"score"` // The best score of a creature with the genome
+	Weights []float64 `json:"weights"`
+}
+
+// HallOfFame is the contents of a hall of fame file, genomes saved from
+// simulation runs sorted from highest to lowest score
+type HallOfFame struct {
+	Version int      `json:"version"`
+	Genomes []Genome `json:"genomes"`
+}
+
+// Add adds g to the hall of fame, replacing a genome with the same weights
+// if the score of g is at least as high
+func (h *HallOfFame) Add(g Genome) {
+	found := false
+	for i := range h.Genomes {
+		t := TopCreature{weights: h.Genomes[i].Weights}
+		if t.WeightsEqual(g.Weights) {
+			if g.Score < h.Genomes[i].Score {
+				return
+			}
+			h.Genomes[i] = g
+			found = true
+			break
+		}
+	}
+	if !found {
+		h.Genomes = append(h.Genomes, g)
+	}
+	sort.Stable(sort.Reverse(genomesByScore(h.Genomes)))
+}
+
+// Trim removes all but the n genomes with the highest scores
+func (h *HallOfFame) Trim(n int) {
+	if len(h.Genomes) > n {
+		h.Genomes = h.Genomes[:n]
+	}
+}
+
+// genomesByScore implements sort.Interface for []Genome
+type genomesByScore []Genome
+
+func (g genomesByScore) Len() int           { return len(g) }
+func (g genomesByScore) Less(i, j int) bool { return g[i].Score < g[j].Score }
+func (g genomesByScore) Swap(i, j int)      { g[i], g[j] = g[j], g[i] }
+
+// LoadHallOfFame reads the hall of fame file at path, a missing file is
+// treated as an empty hall of fame
+func LoadHallOfFame(path string) (*HallOfFame, error) {
+	h := &HallOfFame{Version: hallOfFameVersion}
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return h, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, h); err != nil {
+		return nil, fmt.Errorf("hall of fame: %s: %v", path, err)
+	}
+	if h.Version != hallOfFameVersion {
+		return nil, fmt.Errorf("hall of fame: %s: unsupported version %d, expected %d",
+			path, h.Version, hallOfFameVersion)
+	}
+	return h, nil
+}
+
+// Save writes the hall of fame to path, replacing the file only once it
+// has been written completely
+func (h *HallOfFame) Save(path string) error {
+	b, err := json.MarshalIndent(h, "", "\t")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(b)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// SaveGenome adds g to the hall of fame file at path
+func SaveGenome(path string, g Genome) error {
+	h, err := LoadHallOfFame(path)
+	if err != nil {
+		return err
+	}
+	h.Add(g)
+	return h.Save(path)
+}
+
+// CreatureGenome returns a copy of the genome of the live creature with the
+// given id, with the creature's current score
+func (s *Sim) CreatureGenome(id int) (Genome, bool) {
+	for _, c := range s.creatures {
+		if c.id == id {
+			weights := c.brain.GetWeights()
+			g := Genome{
+				Lineage: c.lineage,
+				Score:   c.score,
+				Weights: make([]float64, len(weights)),
+			}
+			copy(g.Weights, weights)
+			return g, true
+		}
+	}
+	return Genome{}, false
+}
+
+// HallOfFame returns copies of the genomes in the simulation's hall of fame
+// from highest to lowest score
+func (s *Sim) HallOfFame() []Genome {
+	genomes := make([]Genome, len(s.bestCreatures))
+	for i, t := range s.bestCreatures {
+		genomes[i] = Genome{
+			Lineage: t.lineage,
+			Score:   t.score,
+			Weights: make([]float64, len(t.weights)),
+		}
+		copy(genomes[i].Weights, t.weights)
+	}
+	return genomes
+}
+
+// LoadHallOfFame adds genomes to the simulation's hall of fame, so that
+// they are bred from when creatures are next spawned. The genomes are given
+// new ids as ids are only unique within a run, genomes that are already in
+// the hall of fame or have the wrong number of weights are skipped.
+func (s *Sim) LoadHallOfFame(genomes []Genome) {
+	for _, g := range genomes {
+		if len(g.Weights) != numBrainWeights || s.bestCreatures.IndexOfWeights(g.Weights) != -1 {
+			continue
+		}
+		s.nextGenomeID++
+		weights := make([]float64, len(g.Weights))
+		copy(weights, g.Weights)
+		s.bestCreatures = append(s.bestCreatures, &TopCreature{
+			score:   g.Score,
+			weights: weights,
+			lineage: Lineage{
+				GenomeID:   s.nextGenomeID,
+				Generation: g.Generation,
+			},
+		})
+	}
+	s.sortHallOfFame()
+}
+
+// ResetPopulation kills every creature and empties the hall of fame
+func (s *Sim) ResetPopulation() {
+	for len(s.creatures) > 0 {
+		s.killCreature(len(s.creatures)-1, DeathByUser)
+	}
+	for i := range s.bestCreatures {
+		s.bestCreatures[i] = nil
+	}
+	s.bestCreatures = s.bestCreatures[:0]
+	s.bestCreature = nil
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestHallOfFameAdd(t *testing.T) {
+	a := Genome{Score: 30, Weights: []float64{1}}
+	b := Genome{Score: 20, Weights: []float64{2}}
+	c := Genome{Score: 10, Weights: []float64{3}}
+	var h HallOfFame
+	for _, g := range []Genome{c, a, b} {
+		h.Add(g)
+	}
+	checkScores(t, &h, 30, 20, 10)
+
+	// a lower score for known weights is ignored
+	h.Add(Genome{Score: 5, Weights: []float64{1}})
+	checkScores(t, &h, 30, 20, 10)
+
+	// a higher score replaces the genome and keeps the order
+	h.Add(Genome{Score: 40, Weights: []float64{3}})
+	checkScores(t, &h, 40, 30, 20)
+	if !reflect.DeepEqual(h.Genomes[0].Weights, []float64{3}) {
+		t.Errorf("genome 0 has weights %v, want [3]", h.Genomes[0].Weights)
+	}
+}
+
+func checkScores(t *testing.T, h *HallOfFame, scores ...int64) {
+	var got []int64
+	for _, g := range h.Genomes {
+		got = append(got, g.Score)
+	}
+	if !reflect.DeepEqual(got, scores) {
+		t.Fatalf("scores %v, want %v", got, scores)
+	}
+}
+
+func TestSaveGenome(t *testing.T) {
+	dir, err := ioutil.TempDir("", "creaturebox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "hof.json")
+	for _, g := range []Genome{
+		{Score: 10, Weights: []float64{1}},
+		{Score: 20, Weights: []float64{2}},
+		{Score: 30, Weights: []float64{1}},
+	} {
+		if err := SaveGenome(path, g); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h, err := LoadHallOfFame(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkScores(t, h, 30, 20)
+}
+
+func TestHallOfFameTrim(t *testing.T) {
+	cfg := Config{MaxCreatures: 2, MaxBestCreatures: 4}
+	var h HallOfFame
+	for score := int64(1); score <= 4; score++ {
+		h.Add(Genome{Score: score, Weights: []float64{float64(score)}})
+	}
+	// up to MaxBestCreatures genomes are kept
+	h.Trim(cfg.hallOfFameSize(len(h.Genomes)))
+	checkScores(t, &h, 4, 3, 2, 1)
+
+	// more are trimmed to the best MaxCreatures+1
+	h.Add(Genome{Score: 5, Weights: []float64{5}})
+	h.Trim(cfg.hallOfFameSize(len(h.Genomes)))
+	checkScores(t, &h, 5, 4, 3)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/llgcode/draw2d"
+	"github.com/llgcode/draw2d/draw2dkit"
+)
+
+// HUD draws a panel of run statistics in the top right corner of the arena.
+// It is drawn inside the view, which Draw already places below the android
+// status bar.
+type HUD struct {
+	Enabled bool
+	// for measuring the tick rate, which is recomputed about once a second
+	rateStart time.Time
+	rateTick  int
+	rate      float64
+}
+
+// updateRate measures the ticks per second from the tick counter of w
+func (h *HUD) updateRate(w *WorldState) {
+	now := time.Now()
+	if h.rateStart.IsZero() || w.Tick < h.rateTick {
+		h.rateStart, h.rateTick, h.rate = now, w.Tick, 0
+		return
+	}
+	if elapsed := now.Sub(h.rateStart); elapsed >= time.Second {
+		h.rate = float64(w.Tick-h.rateTick) / elapsed.Seconds()
+		h.rateStart, h.rateTick = now, w.Tick
+	}
+}
+
+// lines returns the text of each line of the HUD for w
+func (h *HUD) lines(w *WorldState) []string {
+	var best int64
+	for i := range w.Creatures {
+		if w.Creatures[i].Score > best {
+			best = w.Creatures[i].Score
+		}
+	}
+	lines := []string{
+		fmt.Sprintf("tick %d", w.Tick),
+		fmt.Sprintf("generation %d", w.Cycle),
+		fmt.Sprintf("creatures %d", len(w.Creatures)),
+		fmt.Sprintf("best ever %d", w.BestScore),
+		fmt.Sprintf("best alive %d", best),
+	}
+	if w.MaxLevel > 0 {
+		lines = append(lines, fmt.Sprintf("level %d/%d", w.Level, w.MaxLevel))
+	}
+	return append(lines, fmt.Sprintf("ticks/s %.1f", h.rate))
+}
+
+// Draw draws the HUD for the world state w to gc in the top right of area
+func (h *HUD) Draw(gc draw2d.GraphicContext, w *WorldState, area panelArea) {
+	h.updateRate(w)
+	if !h.Enabled {
+		return
+	}
+	lines := h.lines(w)
+	width := 0.0
+	for _, l := range lines {
+		if tw := textWidth(l); tw > width {
+			width = tw
+		}
+	}
+	width += 2 * overlayPadding
+	height := float64(len(lines)*textLineHeight + 2*overlayPadding)
+	right := area.right - overlayPadding
+	left := right - width
+	top := area.top + overlayPadding
+	gc.Save()
+	defer gc.Restore()
+	gc.SetFillColor(overlayPanelColor)
+	draw2dkit.Rectangle(gc, left, top, right, top+height)
+	gc.Fill()
+	gc.SetFillColor(overlayBarColor)
+	y := top + overlayPadding
+	for _, l := range lines {
+		fillText(gc, l, left+overlayPadding, y)
+		y += textLineHeight
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image"
+
+	"github.com/llgcode/draw2d/draw2dimg"
+)
+
+// InputKind is the kind of an external Input to the simulation
+type InputKind int
+
+const (
+	// InputSpawnRandom spawns a new random creature
+	InputSpawnRandom InputKind = iota
+	// InputKill kills the creature with the ID Target
+	InputKill
+	// InputClone spawns a copy of the creature with the ID Target
+	InputClone
+	// InputMoveObstacle moves the obstacle with the ID Target to X, Y
+	InputMoveObstacle
+	// InputAddWall adds a static wall from X, Y to X2, Y2
+	InputAddWall
+	// InputResetPopulation kills every creature and empties the hall of
+	// fame, so the population starts over from random genomes
+	InputResetPopulation
+	// InputLoadHallOfFame adds Genomes to the hall of fame
+	InputLoadHallOfFame
+	// InputResize resizes the simulation area to X by Y
+	InputResize
+)
+
+// Input is an external change to the simulation such as the user tapping
+// the screen. All changes to a Sim from outside of DoTick should be made
+// with Sim.ApplyInput so that they can be recorded and replayed.
+type Input struct {
+	Kind InputKind
+	// The creature or obstacle the input applies to, if any
+	Target int
+	// Positions within the simulation area, if any, or the new size of
+	// the area for InputResize
+	X, Y   float64
+	X2, Y2 float64
+	// Genomes for InputLoadHallOfFame
+	Genomes []Genome
+}
+
+// InputObserver may be implemented by an Observer to also be notified of
+// each Input applied with Sim.ApplyInput
+type InputObserver interface {
+	// OnInput is called before the input in is applied to the simulation
+	OnInput(s *Sim, in Input)
+}
+
+// ApplyInput applies the external input in to the simulation
+func (s *Sim) ApplyInput(in Input) {
+	for _, o := range s.observers {
+		if r, ok := o.(InputObserver); ok {
+			r.OnInput(s, in)
+		}
+	}
+	switch in.Kind {
+	case InputSpawnRandom:
+		s.SpawnRandomCreature()
+	case InputKill:
+		s.KillCreature(in.Target)
+	case InputClone:
+		s.CloneCreature(in.Target)
+	case InputMoveObstacle:
+		s.MoveObstacle(in.Target, in.X, in.Y)
+	case InputAddWall:
+		s.AddWall(in.X, in.Y, in.X2, in.Y2)
+	case InputResetPopulation:
+		s.ResetPopulation()
+	case InputLoadHallOfFame:
+		s.LoadHallOfFame(in.Genomes)
+	case InputResize:
+		s.Resize(int(in.X), int(in.Y))
+	}
+	// make the change visible before the next tick
+	s.updateState()
+	s.renderer.Render(&s.state)
+}
+
+// creatureIndex returns the index in s.creatures of the live creature with
+// the given id, or -1 if there is none
+func (s *Sim) creatureIndex(id int) int {
+	for i, c := range s.creatures {
+		if c.id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// KillCreature kills the live creature with the given id as if it had
+// collided with something, returning false if there is no such creature
+func (s *Sim) KillCreature(id int) bool {
+	i := s.creatureIndex(id)
+	if i < 0 {
+		return false
+	}
+	s.killCreature(i, DeathByUser)
+	return true
+}
+
+// CloneCreature spawns a creature with the same genome as the live creature
+// with the given id at its position, returning false if there is no such
+// creature
+func (s *Sim) CloneCreature(id int) bool {
+	i := s.creatureIndex(id)
+	if i < 0 {
+		return false
+	}
+	c := s.creatures[i]
+	weights := make([]float64, len(c.brain.GetWeights()))
+	copy(weights, c.brain.GetWeights())
+	s.SpawnCreatureWithLineage(weights, c.lineage)
+	clone := s.creatures[len(s.creatures)-1]
+	clone.x, clone.y = c.x, c.y
+	return true
+}
+
+// MoveObstacle moves the obstacle with the given id so that it starts at
+// x, y, returning false if there is no such obstacle
+func (s *Sim) MoveObstacle(id int, x, y float64) bool {
+	for i := range s.obstacles {
+		if s.obstacles[i].id == id {
+			s.obstacles[i].x, s.obstacles[i].y = x, y
+			return true
+		}
+	}
+	return false
+}
+
+// Resize changes the size of the simulation area to width by height,
+// scaling the positions of the creatures, obstacles and walls to match.
+// Resize returns false if the size is too small.
+func (s *Sim) Resize(width, height int) bool {
+	if width < s.cfg.minArenaSize() || height < s.cfg.minArenaSize() {
+		return false
+	}
+	sx := float64(width) / float64(s.width)
+	sy := float64(height) / float64(s.height)
+	for _, c := range s.creatures {
+		c.x, c.y = c.x*sx, c.y*sy
+		c.sensorX, c.sensorY = c.sensorX*sx, c.sensorY*sy
+	}
+	for i := range s.obstacles {
+		o := &s.obstacles[i]
+		o.x, o.y = o.x*sx, o.y*sy
+		for j := range o.waypoints {
+			p := &o.waypoints[j]
+			p.x, p.y = p.x*sx, p.y*sy
+		}
+	}
+	for i := range s.spawners {
+		if r := s.spawners[i].Region; r != nil {
+			s.spawners[i].Region = r.scaled(sx, sy)
+		}
+	}
+	regions := make([]Region, len(s.cfg.SpawnRegions))
+	for i := range regions {
+		regions[i] = *s.cfg.SpawnRegions[i].scaled(sx, sy)
+	}
+	s.cfg.SpawnRegions = regions
+	for i := range s.walls {
+		l := &s.walls[i]
+		l.x1, l.y1 = l.x1*sx, l.y1*sy
+		l.x2, l.y2 = l.x2*sx, l.y2*sy
+	}
+	s.width, s.height = width, height
+	s.cfg.Width, s.cfg.Height = width, height
+	s.frame = image.NewRGBA(image.Rect(0, 0, width+s.borderWidth*2, height+s.borderWidth*2))
+	s.gc = draw2dimg.NewGraphicContext(s.frame)
+	bounds := s.frame.Bounds()
+	s.frameWidthf = float64(bounds.Dx())
+	s.frameHeightf = float64(bounds.Dy())
+	return true
+}
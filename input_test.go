@@ -0,0 +1,89 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image"
+	"testing"
+)
+
+func TestResize(t *testing.T) {
+	cfg := DefaultConfig()
+	s := NewSim(cfg, 1)
+	s.AddWall(100, 200, 300, 400)
+	for i := 0; i < 10; i++ {
+		s.DoTick()
+	}
+	type point struct{ x, y float64 }
+	var creatures, obstacles []point
+	for _, c := range s.creatures {
+		creatures = append(creatures, point{c.x, c.y})
+	}
+	for _, o := range s.obstacles {
+		obstacles = append(obstacles, point{o.x, o.y})
+	}
+
+	width, height := 2*cfg.Width, cfg.Height/2
+	s.ApplyInput(Input{Kind: InputResize, X: float64(width), Y: float64(height)})
+	sx := float64(width) / float64(cfg.Width)
+	sy := float64(height) / float64(cfg.Height)
+	for i, c := range s.creatures {
+		if want := (point{creatures[i].x * sx, creatures[i].y * sy}); (point{c.x, c.y}) != want {
+			t.Errorf("creature %d moved to (%v, %v), want (%v, %v)", c.id, c.x, c.y, want.x, want.y)
+		}
+	}
+	for i, o := range s.obstacles {
+		if want := (point{obstacles[i].x * sx, obstacles[i].y * sy}); (point{o.x, o.y}) != want {
+			t.Errorf("obstacle %d moved to (%v, %v), want (%v, %v)", o.id, o.x, o.y, want.x, want.y)
+		}
+	}
+	if l := s.walls[0]; l.x1 != 200 || l.y1 != 100 || l.x2 != 600 || l.y2 != 200 {
+		t.Errorf("the wall moved to (%v, %v)-(%v, %v), want (200, 100)-(600, 200)",
+			l.x1, l.y1, l.x2, l.y2)
+	}
+	w := s.State()
+	bounds := image.Rect(0, 0, width+2*cfg.BorderWidth, height+2*cfg.BorderWidth)
+	if w.Width != width || w.Height != height || w.FrameBounds() != bounds || s.frame.Bounds() != bounds {
+		t.Errorf("resized to %dx%d with a %v frame, want %dx%d with a %v frame",
+			w.Width, w.Height, s.frame.Bounds(), width, height, bounds)
+	}
+	// the resized simulation keeps running
+	for i := 0; i < 10; i++ {
+		s.DoTick()
+	}
+
+	// too small sizes are ignored
+	if s.Resize(cfg.minArenaSize()-1, height) || s.Resize(width, cfg.minArenaSize()-1) {
+		t.Errorf("resized to less than %d", cfg.minArenaSize())
+	}
+	if s.width != width || s.height != height {
+		t.Errorf("resized to %dx%d, want %dx%d", s.width, s.height, width, height)
+	}
+}
+
+func TestLandscapeObstacles(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Width, cfg.Height = cfg.Height, cfg.Width
+	s := NewSim(cfg, 1)
+	for i := 0; i < 200; i++ {
+		o := s.newObstacle(0)
+		if o.x < 0 || o.x >= float64(cfg.Width) || o.y < 0 || o.y >= float64(cfg.Height) {
+			t.Fatalf("obstacle spawned at (%v, %v) outside the %dx%d area",
+				o.x, o.y, cfg.Width, cfg.Height)
+		}
+	}
+}
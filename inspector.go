@@ -0,0 +1,228 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/llgcode/draw2d"
+	"github.com/llgcode/draw2d/draw2dkit"
+)
+
+// inspector panel layout, in frame pixels
+const (
+	inspectorWidth    = 208
+	inspectorCellSize = 2 // the size of each weight in the weight grids
+	// the distance from a creature's center a tap may be to select it, in
+	// creature radii
+	inspectorPickRadii = 3
+)
+
+var (
+	inspectorTextColor   = color.RGBA{0x20, 0x20, 0x20, 0xFF}
+	inspectorButtonColor = color.RGBA{0x30, 0x60, 0xC0, 0xFF}
+)
+
+// Inspector draws a panel describing a selected creature: its age, score,
+// genome and parents, its live sensor values, outputs and memory, and its
+// brain weights. The panel has a button for saving the creature's genome.
+type Inspector struct {
+	Selected int // The ID of the inspected creature, zero for none
+	Saved    int // The genome ID last saved, shown on the button
+}
+
+// PickCreature returns the ID of the creature nearest the frame position
+// x, y if it is within a few radii, or zero if there is none
+func PickCreature(w *WorldState, x, y float64) int {
+	borderWidthf := float64(w.BorderWidth)
+	id := 0
+	best := inspectorPickRadii * w.CreatureRadius
+	for i := range w.Creatures {
+		c := &w.Creatures[i]
+		if d := xyDist(x, y, borderWidthf+c.X, borderWidthf+c.Y); d <= best {
+			id = c.ID
+			best = d
+		}
+	}
+	return id
+}
+
+// creature returns the selected creature or nil if it is not alive
+func (in *Inspector) creature(w *WorldState) *CreatureState {
+	if in.Selected == 0 {
+		return nil
+	}
+	for i := range w.Creatures {
+		if w.Creatures[i].ID == in.Selected {
+			return &w.Creatures[i]
+		}
+	}
+	return nil
+}
+
+// layout returns the view position of the top left corner of the panel
+// and its height, the panel sits in the bottom left corner of area
+func (in *Inspector) layout(area panelArea) (left, top, height float64) {
+	height = overlayPadding + 4*textLineHeight + overlayPadding +
+		3*(overlayRowHeight+overlayPadding) +
+		(numBrainInputs+memorySize)*inspectorCellSize + overlayPadding
+	left = area.left + overlayPadding
+	top = area.bottom - overlayPadding - height
+	return left, top, height
+}
+
+// beside returns the part of area that a panel width wide in its bottom
+// right corner can be laid out in without overlapping the inspector panel.
+// That is above the inspector panel when there is no room beside it.
+func (in *Inspector) beside(w *WorldState, area panelArea, width float64) panelArea {
+	if in.creature(w) == nil {
+		return area
+	}
+	left, top, _ := in.layout(area)
+	if left+inspectorWidth+overlayPadding <= area.right-overlayPadding-width {
+		return area
+	}
+	area.bottom = top
+	return area
+}
+
+// buttonRect returns the view rectangle of the save genome button
+func (in *Inspector) buttonRect(area panelArea) (x1, y1, x2, y2 float64) {
+	left, top, height := in.layout(area)
+	x2 = left + inspectorWidth - overlayPadding
+	x1 = x2 - textWidth("save genome") - 2*overlayPadding
+	y2 = top + height - overlayPadding
+	y1 = y2 - textLineHeight - 2*overlayPadding
+	return x1, y1, x2, y2
+}
+
+// ButtonContains returns true if the panel is shown and its save genome
+// button contains the view position x, y in area
+func (in *Inspector) ButtonContains(w *WorldState, area panelArea, x, y float64) bool {
+	if in.creature(w) == nil {
+		return false
+	}
+	x1, y1, x2, y2 := in.buttonRect(area)
+	return x >= x1 && x < x2 && y >= y1 && y < y2
+}
+
+// DrawSelection draws a ring around the selected creature, if it is alive,
+// to gc in frame coordinates
+func (in *Inspector) DrawSelection(gc draw2d.GraphicContext, w *WorldState) {
+	c := in.creature(w)
+	if c == nil {
+		return
+	}
+	gc.Save()
+	defer gc.Restore()
+	drawSelectionRing(gc, w, c)
+}
+
+// Draw draws the panel for the selected creature, if it is alive, to gc in
+// the bottom left of area
+func (in *Inspector) Draw(gc draw2d.GraphicContext, w *WorldState, area panelArea) {
+	c := in.creature(w)
+	if c == nil {
+		return
+	}
+	gc.Save()
+	defer gc.Restore()
+	left, top, height := in.layout(area)
+	gc.SetFillColor(overlayPanelColor)
+	draw2dkit.Rectangle(gc, left, top, left+inspectorWidth, top+height)
+	gc.Fill()
+	x := left + overlayPadding
+	y := top + overlayPadding
+	// description
+	parents := "random genome"
+	if c.Lineage.Parents[0] != 0 {
+		parents = fmt.Sprintf("parents %d, %d", c.Lineage.Parents[0], c.Lineage.Parents[1])
+	}
+	gc.SetFillColor(inspectorTextColor)
+	for _, line := range []string{
+		fmt.Sprintf("creature %d  age %d", c.ID, w.Tick-c.Born),
+		fmt.Sprintf("score %d", c.Score),
+		fmt.Sprintf("genome %d  generation %d", c.Lineage.GenomeID, c.Lineage.Generation),
+		parents,
+	} {
+		fillText(gc, line, x, y)
+		y += textLineHeight
+	}
+	y += overlayPadding
+	// live brain state
+	labelX := x + memorySize*overlayBarWidth + overlayPadding
+	labelY := float64(overlayRowHeight-textLineHeight) / 2
+	drawSensorBars(gc, x, y, c, maxSensorDist(w))
+	gc.SetFillColor(inspectorTextColor)
+	fillText(gc, "sensors", labelX, y+labelY)
+	y += overlayRowHeight + overlayPadding
+	drawOutputBars(gc, x, y, c)
+	gc.SetFillColor(inspectorTextColor)
+	fillText(gc, fmt.Sprintf("turn %+.2f move %+.2f", c.Turn, c.Move),
+		x+2*overlayBarWidth+overlayPadding, y+labelY)
+	y += overlayRowHeight + overlayPadding
+	drawMemoryBars(gc, x, y, c)
+	gc.SetFillColor(inspectorTextColor)
+	fillText(gc, "memory", labelX, y+labelY)
+	y += overlayRowHeight + overlayPadding
+	// weights, a row per perceptron of each layer, see NewRandomBrain
+	inRows := numBrainInputs + memorySize
+	inWeightLen := numBrainInputs + memorySize + 1
+	outWeightLen := inRows + 1
+	split := inRows * inWeightLen
+	drawWeightGrid(gc, x, y, c.Weights[:split], inWeightLen)
+	drawWeightGrid(gc, x+float64(inWeightLen*inspectorCellSize+overlayPadding), y,
+		c.Weights[split:], outWeightLen)
+	// save genome button
+	x1, y1, x2, y2 := in.buttonRect(area)
+	gc.SetFillColor(inspectorButtonColor)
+	draw2dkit.Rectangle(gc, x1, y1, x2, y2)
+	gc.Fill()
+	label := "save genome"
+	if in.Saved == c.Lineage.GenomeID {
+		label = "saved"
+	}
+	gc.SetFillColor(color.White)
+	fillText(gc, label, x1+overlayPadding, y1+overlayPadding)
+}
+
+// drawWeightGrid draws weights as a grid of cells at x, y with cols cells
+// per row, positive weights in green and negative in red
+func drawWeightGrid(gc draw2d.GraphicContext, x, y float64, weights []float64, cols int) {
+	// weights are in [-1, 1], quantize them to a few shades so that each
+	// shade can be filled as a single path
+	const shades = 4
+	for shade := -shades; shade <= shades; shade++ {
+		v := uint8(0xFF * math.Abs(float64(shade)) / shades)
+		if shade < 0 {
+			gc.SetFillColor(color.RGBA{v, 0, 0, 0xFF})
+		} else {
+			gc.SetFillColor(color.RGBA{0, v, 0, 0xFF})
+		}
+		for i, wt := range weights {
+			if int(math.Floor(wt*shades+0.5)) != shade {
+				continue
+			}
+			cx := x + float64(i%cols*inspectorCellSize)
+			cy := y + float64(i/cols*inspectorCellSize)
+			draw2dkit.Rectangle(gc, cx, cy, cx+inspectorCellSize, cy+inspectorCellSize)
+		}
+		gc.Fill()
+	}
+}
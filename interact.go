@@ -0,0 +1,429 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"time"
+
+	"golang.org/x/mobile/event/touch"
+)
+
+// gesture thresholds
+const (
+	longPressDuration = 500 * time.Millisecond
+	doubleTapDuration = 350 * time.Millisecond
+	// the distance in view pixels a touch may move and still be a tap
+	tapSlop = 6
+	// the distance from an obstacle a touch may be to grab it
+	obstaclePickRadius = 10
+)
+
+// gestureKind is what a touch is doing
+type gestureKind int
+
+const (
+	// gestureNone is for touches that are being ignored
+	gestureNone gestureKind = iota
+	// gesturePress is for touches that have not moved yet, which are taps,
+	// double taps or long presses, see tapRecognizer
+	gesturePress
+	// gestureDragObstacle moves the grabbed obstacle with the touch
+	gestureDragObstacle
+	// gestureDrawWall draws a wall from the start of the touch
+	gestureDrawWall
+)
+
+// gesture is the state of the touch currently interacting with the arena
+type gesture struct {
+	kind     gestureKind
+	sequence touch.Sequence
+	// the start of the touch in view and frame coordinates
+	vx0, vy0 float64
+	x0, y0   float64
+	// the creature or obstacle under the start of the touch, if any
+	creature int
+	obstacle int
+	// the offset from the touch to the start of the grabbed obstacle
+	grabX, grabY float64
+}
+
+// gest is the current gesture
+var gest gesture
+
+// tapKind is the kind of a tapEvent
+type tapKind int
+
+const (
+	tapSingle tapKind = iota
+	tapDouble
+	tapLong
+)
+
+// tapEvent is a tap recognized by a tapRecognizer, at the view position
+// vx, vy on the creature that was under the finger when it touched down
+type tapEvent struct {
+	kind     tapKind
+	vx, vy   float64
+	creature int
+}
+
+// tapRecognizer turns the presses of a finger that does not move into taps,
+// double taps and long presses. Only presses on creatures can be double taps
+// or long presses. A long press is recognized as soon as the finger has been
+// down for longPressDuration, and a tap on a creature is held back until it
+// can no longer become a double tap, so it is only recognized when update is
+// called after doubleTapDuration has passed.
+type tapRecognizer struct {
+	pressed  bool
+	long     bool // whether the current press was a long press
+	start    time.Time
+	vx, vy   float64
+	creature int
+	// the tap held back in case it becomes a double tap, and when it ended
+	pending   *tapEvent
+	pendingAt time.Time
+}
+
+// down starts a press at the view position vx, vy on creature, which may be
+// zero, at now
+func (r *tapRecognizer) down(now time.Time, vx, vy float64, creature int) {
+	r.pressed = true
+	r.long = false
+	r.start = now
+	r.vx, r.vy = vx, vy
+	r.creature = creature
+}
+
+// cancel abandons the current press, such as when the finger moves
+func (r *tapRecognizer) cancel() {
+	r.pressed = false
+}
+
+// up ends the press at the view position vx, vy at now and returns the
+// taps recognized
+func (r *tapRecognizer) up(now time.Time, vx, vy float64) []tapEvent {
+	if !r.pressed {
+		return nil
+	}
+	r.pressed = false
+	if r.long {
+		return nil
+	}
+	tap := tapEvent{kind: tapSingle, vx: vx, vy: vy, creature: r.creature}
+	var events []tapEvent
+	if p := r.pending; p != nil {
+		r.pending = nil
+		if tap.creature != 0 && p.creature == tap.creature &&
+			now.Sub(r.pendingAt) < doubleTapDuration {
+			tap.kind = tapDouble
+			return append(events, tap)
+		}
+		events = append(events, *p)
+	}
+	if tap.creature == 0 {
+		return append(events, tap)
+	}
+	r.pending, r.pendingAt = &tap, now
+	return events
+}
+
+// update returns the long press or held back tap that is due at now
+func (r *tapRecognizer) update(now time.Time) []tapEvent {
+	var events []tapEvent
+	if p := r.pending; p != nil && now.Sub(r.pendingAt) >= doubleTapDuration {
+		r.pending = nil
+		events = append(events, *p)
+	}
+	if r.pressed && !r.long && r.creature != 0 && now.Sub(r.start) >= longPressDuration {
+		r.long = true
+		events = append(events, tapEvent{kind: tapLong, vx: r.vx, vy: r.vy, creature: r.creature})
+	}
+	return events
+}
+
+// taps recognizes the taps of the current gesture
+var taps tapRecognizer
+
+// PickObstacle returns the id of the obstacle nearest the frame position
+// x, y and the position of its start, or center for circles and polygons,
+// relative to x, y if it is within obstaclePickRadius, or zero if there is none
+func PickObstacle(w *WorldState, x, y float64) (id int, dx, dy float64) {
+	borderWidthf := float64(w.BorderWidth)
+	best := float64(obstaclePickRadius)
+	for i := range w.Obstacles {
+		o := &w.Obstacles[i]
+		x1 := borderWidthf + o.X
+		y1 := borderWidthf + o.Y
+		if d := o.Dist(x-borderWidthf, y-borderWidthf); d <= best {
+			id, dx, dy = o.ID, x1-x, y1-y
+			best = d
+		}
+	}
+	return id, dx, dy
+}
+
+// viewPoint is a position in view coordinates
+type viewPoint struct {
+	x, y float64
+}
+
+// fingers holds the view position of each finger touching the screen
+var fingers = map[touch.Sequence]viewPoint{}
+
+// pinch is the state of a two finger gesture, which zooms the camera by the
+// change in distance between the fingers and pans it with their midpoint.
+// A pinch that does not move is a two finger tap, which toggles the overlay.
+type pinch struct {
+	active bool
+	seqs   [2]touch.Sequence
+	// the midpoint of the fingers when the pinch started
+	mx0, my0 float64
+	// the distance the fingers moved in total, and whether the midpoint has
+	// moved far enough to pan, in view pixels
+	travel  float64
+	panning bool
+}
+
+// pin is the current pinch
+var pin pinch
+
+// TouchBegin handles a finger touching the screen at the view position
+// vx, vy. The first finger starts a gesture: touching an obstacle and
+// dragging moves it, dragging from anywhere else draws a wall, and touches
+// that do not move are taps, see TouchEnd. A second finger starts a pinch
+// instead, and a third toggles the HUD.
+func TouchBegin(seq touch.Sequence, vx, vy float64) {
+	fingers[seq] = viewPoint{vx, vy}
+	switch len(fingers) {
+	case 1:
+		beginGesture(seq, vx, vy)
+	case 2:
+		TouchCancel()
+		beginPinch()
+	case 3:
+		pin.active = false
+		renderer.HUD.Enabled = !renderer.HUD.Enabled
+	}
+}
+
+// beginGesture starts a gesture for the finger seq at vx, vy
+func beginGesture(seq touch.Sequence, vx, vy float64) {
+	w := sim.State()
+	fx, fy := renderer.Camera.ToFrame(vx, vy)
+	gest.kind = gesturePress
+	gest.sequence = seq
+	gest.vx0, gest.vy0 = vx, vy
+	gest.x0, gest.y0 = fx, fy
+	gest.creature = PickCreature(w, fx, fy)
+	gest.obstacle = 0
+	if gest.creature == 0 && !renderer.Inspector.ButtonContains(w, renderer.Area(), vx, vy) {
+		gest.obstacle, gest.grabX, gest.grabY = PickObstacle(w, fx, fy)
+	}
+	taps.down(time.Now(), vx, vy, gest.creature)
+}
+
+// beginPinch starts a pinch with the two fingers touching the screen
+func beginPinch() {
+	i := 0
+	for seq := range fingers {
+		pin.seqs[i] = seq
+		i++
+	}
+	a, b := fingers[pin.seqs[0]], fingers[pin.seqs[1]]
+	pin.active = true
+	pin.mx0, pin.my0 = (a.x+b.x)/2, (a.y+b.y)/2
+	pin.travel = 0
+	pin.panning = false
+}
+
+// TouchMove updates the gesture or pinch as the finger seq moves to the
+// view position vx, vy
+func TouchMove(seq touch.Sequence, vx, vy float64) {
+	old, ok := fingers[seq]
+	if !ok {
+		return
+	}
+	fingers[seq] = viewPoint{vx, vy}
+	if pin.active {
+		movePinch(seq, old)
+		return
+	}
+	if gest.kind == gestureNone || seq != gest.sequence {
+		return
+	}
+	if gest.kind == gesturePress {
+		if xyDist(gest.vx0, gest.vy0, vx, vy) < tapSlop {
+			return
+		}
+		taps.cancel()
+		// the simulation can only be changed when not replaying
+		switch {
+		case player != nil || gest.creature != 0:
+			gest.kind = gestureNone
+		case gest.obstacle != 0:
+			gest.kind = gestureDragObstacle
+		default:
+			gest.kind = gestureDrawWall
+		}
+	}
+	fx, fy := renderer.Camera.ToFrame(vx, vy)
+	borderWidthf := float64(sim.State().BorderWidth)
+	switch gest.kind {
+	case gestureDragObstacle:
+		sim.ApplyInput(Input{
+			Kind:   InputMoveObstacle,
+			Target: gest.obstacle,
+			X:      fx + gest.grabX - borderWidthf,
+			Y:      fy + gest.grabY - borderWidthf,
+		})
+	case gestureDrawWall:
+		renderer.Preview = LinePreview{
+			Enabled: true,
+			X1:      gest.x0,
+			Y1:      gest.y0,
+			X2:      fx,
+			Y2:      fy,
+		}
+	}
+}
+
+// movePinch zooms and pans the camera after the pinching finger seq moved
+// from old
+func movePinch(seq touch.Sequence, old viewPoint) {
+	a, b := fingers[pin.seqs[0]], fingers[pin.seqs[1]]
+	pa, pb := a, b
+	if seq == pin.seqs[0] {
+		pa = old
+	} else {
+		pb = old
+	}
+	mx, my := (a.x+b.x)/2, (a.y+b.y)/2
+	pin.travel += xyDist(old.x, old.y, fingers[seq].x, fingers[seq].y)
+	// only pan once the midpoint clearly moves, so that zooming in on a
+	// followed creature keeps following it
+	if !pin.panning && xyDist(pin.mx0, pin.my0, mx, my) >= tapSlop {
+		pin.panning = true
+	}
+	camera := &renderer.Camera
+	if pin.panning {
+		camera.Pan(mx-(pa.x+pb.x)/2, my-(pa.y+pb.y)/2)
+	}
+	if d := xyDist(pa.x, pa.y, pb.x, pb.y); d > 0 {
+		camera.ZoomAt(mx, my, xyDist(a.x, a.y, b.x, b.y)/d)
+	}
+}
+
+// TouchEnd finishes the gesture or pinch when the finger seq is lifted at
+// the view position vx, vy. A wall being drawn is added to the simulation,
+// and presses are handed to the tapRecognizer, see handleTaps.
+func TouchEnd(seq touch.Sequence, vx, vy float64) {
+	if _, ok := fingers[seq]; !ok {
+		return
+	}
+	delete(fingers, seq)
+	if pin.active {
+		if seq == pin.seqs[0] || seq == pin.seqs[1] {
+			pin.active = false
+			if pin.travel < tapSlop {
+				renderer.Overlay.Enabled = !renderer.Overlay.Enabled
+			}
+		}
+		return
+	}
+	if gest.kind == gestureNone || seq != gest.sequence {
+		return
+	}
+	kind := gest.kind
+	gest.kind = gestureNone
+	renderer.Preview.Enabled = false
+	switch kind {
+	case gestureDrawWall:
+		fx, fy := renderer.Camera.ToFrame(vx, vy)
+		borderWidthf := float64(sim.State().BorderWidth)
+		sim.ApplyInput(Input{
+			Kind: InputAddWall,
+			X:    gest.x0 - borderWidthf,
+			Y:    gest.y0 - borderWidthf,
+			X2:   fx - borderWidthf,
+			Y2:   fy - borderWidthf,
+		})
+	case gesturePress:
+		handleTaps(taps.up(time.Now(), vx, vy))
+	}
+}
+
+// UpdateGestures handles the long presses and held back taps that are due,
+// it is called every frame
+func UpdateGestures() {
+	handleTaps(taps.update(time.Now()))
+}
+
+// handleTaps handles recognized taps. A long press on a creature kills it and
+// a double tap clones it, other taps are handled by Tap. The simulation can
+// only be changed when not replaying, so then every tap is handled by Tap.
+func handleTaps(events []tapEvent) {
+	for _, e := range events {
+		switch {
+		case e.kind == tapLong && player == nil:
+			sim.ApplyInput(Input{Kind: InputKill, Target: e.creature})
+		case e.kind == tapDouble && player == nil:
+			sim.ApplyInput(Input{Kind: InputClone, Target: e.creature})
+		default:
+			Tap(e.vx, e.vy, e.creature)
+		}
+	}
+}
+
+// Tap handles a single finger tap at the view position vx, vy on the
+// creature id picked when the finger touched down, which may be zero.
+// Tapping on or near a creature inspects it and follows it with the camera,
+// and tapping the inspector's button saves the creature's genome. Otherwise
+// a tap closes the inspector, or spawns a random creature if it is not open.
+func Tap(vx, vy float64, id int) {
+	w := sim.State()
+	inspector := &renderer.Inspector
+	switch {
+	case inspector.ButtonContains(w, renderer.Area(), vx, vy):
+		SaveSelectedGenome()
+	case id != 0:
+		inspector.Selected = id
+		renderer.Overlay.Selected = id
+		renderer.Camera.Follow = id
+	case inspector.Selected != 0:
+		inspector.Selected = 0
+		renderer.Overlay.Selected = 0
+		renderer.Camera.Follow = 0
+	case player == nil:
+		sim.ApplyInput(Input{Kind: InputSpawnRandom})
+	}
+}
+
+// TouchCancel abandons the current gesture, such as when a second finger
+// touches the screen
+func TouchCancel() {
+	gest.kind = gestureNone
+	taps.cancel()
+	renderer.Preview.Enabled = false
+}
+
+// ResetTouches forgets every finger touching the screen, for when touch
+// events may have been missed such as while the app is not focused
+func ResetTouches() {
+	fingers = map[touch.Sequence]viewPoint{}
+	pin.active = false
+	TouchCancel()
+}
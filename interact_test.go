@@ -0,0 +1,156 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTapRecognizer(t *testing.T) {
+	// a step is a touch down, touch up, cancel or update at a time in
+	// milliseconds, on a creature for touch downs
+	type step struct {
+		op       string
+		ms       int
+		creature int
+		want     []tapKind
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{"tap away from creatures", []step{
+			{"down", 0, 0, nil},
+			{"up", 100, 0, []tapKind{tapSingle}},
+			{"update", 1000, 0, nil},
+		}},
+		{"tap on a creature waits for a double tap", []step{
+			{"down", 0, 1, nil},
+			{"up", 100, 0, nil},
+			{"update", 100 + 349, 0, nil},
+			{"update", 100 + 350, 0, []tapKind{tapSingle}},
+			{"update", 1000, 0, nil},
+		}},
+		{"double tap", []step{
+			{"down", 0, 1, nil},
+			{"up", 100, 0, nil},
+			{"down", 200, 1, nil},
+			{"up", 300, 0, []tapKind{tapDouble}},
+			{"update", 1000, 0, nil},
+		}},
+		{"a third tap starts again", []step{
+			{"down", 0, 1, nil},
+			{"up", 50, 0, nil},
+			{"down", 100, 1, nil},
+			{"up", 150, 0, []tapKind{tapDouble}},
+			{"down", 200, 1, nil},
+			{"up", 250, 0, nil},
+			{"update", 250 + 350, 0, []tapKind{tapSingle}},
+		}},
+		{"taps too far apart", []step{
+			{"down", 0, 1, nil},
+			{"up", 100, 0, nil},
+			{"down", 400, 1, nil},
+			{"up", 450, 0, []tapKind{tapSingle}},
+			{"update", 450 + 350, 0, []tapKind{tapSingle}},
+		}},
+		{"taps on different creatures", []step{
+			{"down", 0, 1, nil},
+			{"up", 100, 0, nil},
+			{"down", 200, 2, nil},
+			{"up", 300, 0, []tapKind{tapSingle}},
+			{"update", 300 + 350, 0, []tapKind{tapSingle}},
+		}},
+		{"long press while held", []step{
+			{"down", 0, 1, nil},
+			{"update", 499, 0, nil},
+			{"update", 500, 0, []tapKind{tapLong}},
+			{"update", 600, 0, nil},
+			{"up", 700, 0, nil},
+			{"update", 2000, 0, nil},
+		}},
+		{"long press away from creatures is a tap", []step{
+			{"down", 0, 0, nil},
+			{"update", 1000, 0, nil},
+			{"up", 1100, 0, []tapKind{tapSingle}},
+		}},
+		{"cancelled press", []step{
+			{"down", 0, 1, nil},
+			{"cancel", 100, 0, nil},
+			{"update", 1000, 0, nil},
+			{"up", 1100, 0, nil},
+		}},
+		{"held back tap is kept when the next press is cancelled", []step{
+			{"down", 0, 1, nil},
+			{"up", 100, 0, nil},
+			{"down", 200, 1, nil},
+			{"cancel", 250, 0, nil},
+			{"update", 100 + 350, 0, []tapKind{tapSingle}},
+		}},
+	}
+	for _, test := range tests {
+		var r tapRecognizer
+		var start time.Time
+		for i, s := range test.steps {
+			now := start.Add(time.Duration(s.ms) * time.Millisecond)
+			var events []tapEvent
+			switch s.op {
+			case "down":
+				r.down(now, float64(s.ms), 0, s.creature)
+			case "up":
+				events = r.up(now, float64(s.ms), 0)
+			case "cancel":
+				r.cancel()
+			case "update":
+				events = r.update(now)
+			}
+			var got []tapKind
+			for _, e := range events {
+				got = append(got, e.kind)
+			}
+			if !reflect.DeepEqual(got, s.want) {
+				t.Errorf("%s: step %d (%s at %dms) recognized %v, want %v",
+					test.name, i, s.op, s.ms, got, s.want)
+			}
+		}
+	}
+}
+
+func TestTapRecognizerEvents(t *testing.T) {
+	var r tapRecognizer
+	var start time.Time
+	ms := func(n int) time.Time {
+		return start.Add(time.Duration(n) * time.Millisecond)
+	}
+	// a long press is where the finger touched down
+	r.down(ms(0), 10, 20, 3)
+	want := []tapEvent{{kind: tapLong, vx: 10, vy: 20, creature: 3}}
+	if got := r.update(ms(500)); !reflect.DeepEqual(got, want) {
+		t.Errorf("long press %v, want %v", got, want)
+	}
+	r.up(ms(600), 15, 25)
+
+	// taps are where the finger lifted, on the creature it touched down on
+	r.down(ms(1000), 30, 40, 4)
+	r.up(ms(1100), 31, 41)
+	want = []tapEvent{{kind: tapSingle, vx: 31, vy: 41, creature: 4}}
+	if got := r.update(ms(2000)); !reflect.DeepEqual(got, want) {
+		t.Errorf("tap %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"math"
+)
+
+// CopyTo makes dst a deep copy of w, reusing dst's memory where possible,
+// so that dst stays valid after w changes
+func (w *WorldState) CopyTo(dst *WorldState) {
+	dst.Tick = w.Tick
+	dst.Width = w.Width
+	dst.Height = w.Height
+	dst.BorderWidth = w.BorderWidth
+	dst.BestScore = w.BestScore
+	dst.Cycle = w.Cycle
+	dst.Level = w.Level
+	dst.MaxLevel = w.MaxLevel
+	dst.CreatureRadius = w.CreatureRadius
+	dst.ObstacleWidth = w.ObstacleWidth
+	n := len(w.Creatures)
+	if cap(dst.Creatures) < n {
+		dst.Creatures = append(dst.Creatures[:cap(dst.Creatures)],
+			make([]CreatureState, n-cap(dst.Creatures))...)
+	}
+	dst.Creatures = dst.Creatures[:n]
+	for i := range w.Creatures {
+		c := &dst.Creatures[i]
+		// keep the slices for reuse
+		sensors, memory, weights := c.Sensors, c.Memory, c.Weights
+		*c = w.Creatures[i]
+		c.Sensors = append(sensors[:0], w.Creatures[i].Sensors...)
+		c.Memory = append(memory[:0], w.Creatures[i].Memory...)
+		c.Weights = append(weights[:0], w.Creatures[i].Weights...)
+	}
+	dst.Obstacles = append(dst.Obstacles[:0], w.Obstacles...)
+	dst.Walls = append(dst.Walls[:0], w.Walls...)
+}
+
+// Interpolator is a Renderer that keeps copies of the last two world states
+// so that frames can be drawn in between ticks
+type Interpolator struct {
+	prev WorldState
+	cur  WorldState
+}
+
+// Render implements Renderer by keeping a copy of w. States for the same
+// tick, such as after an Input, replace the last state.
+func (p *Interpolator) Render(w *WorldState) {
+	if w.Tick != p.cur.Tick {
+		p.prev, p.cur = p.cur, p.prev
+	}
+	w.CopyTo(&p.cur)
+}
+
+// State sets dst to the state alpha of the way from the second to last
+// state to the last, where alpha is in [0, 1]. Creatures and obstacles that
+// are not in both states are taken from the last state.
+func (p *Interpolator) State(alpha float64, dst *WorldState) {
+	p.cur.CopyTo(dst)
+	if alpha >= 1 || p.prev.Tick != p.cur.Tick-1 {
+		return
+	}
+	for i := range dst.Creatures {
+		c := &dst.Creatures[i]
+		for j := range p.prev.Creatures {
+			if prev := &p.prev.Creatures[j]; prev.ID == c.ID {
+				c.X = lerp(prev.X, c.X, alpha)
+				c.Y = lerp(prev.Y, c.Y, alpha)
+				c.Angle = lerpAngle(prev.Angle, c.Angle, alpha)
+				break
+			}
+		}
+	}
+	for i := range dst.Obstacles {
+		o := &dst.Obstacles[i]
+		for j := range p.prev.Obstacles {
+			if prev := &p.prev.Obstacles[j]; prev.ID == o.ID {
+				o.X = lerp(prev.X, o.X, alpha)
+				o.Y = lerp(prev.Y, o.Y, alpha)
+				o.Angle = lerpAngle(prev.Angle, o.Angle, alpha)
+				o.Length = lerp(prev.Length, o.Length, alpha)
+				break
+			}
+		}
+	}
+}
+
+// lerp returns the value t of the way from a to b
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+// lerpAngle returns the angle t of the way from a to b turning the
+// shortest way around
+func lerpAngle(a, b, t float64) float64 {
+	d := math.Remainder(b-a, 2*math.Pi)
+	return a + d*t
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+// TestWorldStateCopyTo checks that CopyTo copies every field, setting the
+// numeric fields by reflection so that new fields are covered too
+func TestWorldStateCopyTo(t *testing.T) {
+	var w WorldState
+	v := reflect.ValueOf(&w).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		switch f := v.Field(i); f.Kind() {
+		case reflect.Int, reflect.Int64:
+			f.SetInt(int64(i + 1))
+		case reflect.Float64:
+			f.SetFloat(float64(i) + 0.5)
+		}
+	}
+	w.Creatures = []CreatureState{{
+		ID:      1,
+		X:       2,
+		Color:   color.RGBA{1, 2, 3, 255},
+		Sensors: []float64{1, 2},
+		Memory:  []float64{3},
+		Weights: []float64{4, 5, 6},
+	}}
+	w.Obstacles = []ObstacleState{{ID: 2, Kind: ObstaclePolygon, Sides: 5}}
+	w.Walls = []WallState{{ID: 3, X2: 10, Width: 4}}
+
+	var dst WorldState
+	w.CopyTo(&dst)
+	if !reflect.DeepEqual(w, dst) {
+		t.Fatalf("CopyTo() = %+v, want %+v", dst, w)
+	}
+	// the copy must not share memory with w
+	w.Creatures[0].Weights[0] = 7
+	if dst.Creatures[0].Weights[0] != 4 {
+		t.Errorf("CopyTo() shares the creature weights")
+	}
+}
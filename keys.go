@@ -0,0 +1,178 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"log"
+
+	"golang.org/x/mobile/event/key"
+)
+
+// maxSpeed is the largest multiple of the -tps rate the simulation runs at
+const maxSpeed = 64
+
+var (
+	paused bool // true if the simulation is paused
+	speed  = 1  // the multiple of the -tps rate to run at
+	steps  int  // the number of ticks to run while paused
+)
+
+// setSpeed sets the multiple of the -tps rate the simulation runs at and
+// logs it. With -tps 0 every frame already runs as many ticks as fit in it,
+// so the speed can not change and the keys only say so.
+func setSpeed(s int) {
+	if *ticksPerSecond <= 0 {
+		log.Print("speed: -tps 0 already runs as fast as possible")
+		return
+	}
+	speed = s
+	log.Printf("speed: %dx", speed)
+}
+
+// HandleKey handles the desktop keyboard controls:
+//
+//	space, p  pause or resume
+//	n, right  run a single tick while paused
+//	+, up     double the speed
+//	-, down   halve the speed
+//	1         reset the speed
+//	r         reset the population
+//	o         toggle the sensor overlay
+//	h         toggle the HUD
+//	c         toggle the charts
+//	escape    close the creature inspector
+//	s         save the hall of fame to the -halloffame file
+//	l         load the hall of fame from the -halloffame file
+//	z, x      zoom the camera in or out
+//	shift+arrows pan the camera
+//	f         follow the inspected creature with the camera
+//	0         reset the camera
+func HandleKey(e key.Event) {
+	if e.Direction != key.DirPress {
+		return
+	}
+	if e.Modifiers&key.ModShift != 0 && panKey(e.Code) {
+		return
+	}
+	camera := &renderer.Camera
+	switch e.Code {
+	case key.CodeSpacebar, key.CodeP:
+		paused = !paused
+		log.Printf("paused: %v", paused)
+	case key.CodeN, key.CodeRightArrow:
+		if paused {
+			steps++
+		}
+	case key.CodeEqualSign, key.CodeKeypadPlusSign, key.CodeUpArrow:
+		if speed < maxSpeed {
+			setSpeed(speed * 2)
+		} else {
+			setSpeed(speed)
+		}
+	case key.CodeHyphenMinus, key.CodeKeypadHyphenMinus, key.CodeDownArrow:
+		if speed > 1 {
+			setSpeed(speed / 2)
+		} else {
+			setSpeed(speed)
+		}
+	case key.Code1:
+		setSpeed(1)
+	case key.CodeR:
+		if player == nil {
+			sim.ApplyInput(Input{Kind: InputResetPopulation})
+		}
+	case key.CodeO:
+		renderer.Overlay.Enabled = !renderer.Overlay.Enabled
+	case key.CodeH:
+		renderer.HUD.Enabled = !renderer.HUD.Enabled
+	case key.CodeC:
+		renderer.Charts.Enabled = !renderer.Charts.Enabled
+	case key.CodeEscape:
+		renderer.Inspector.Selected = 0
+		renderer.Overlay.Selected = 0
+		camera.Follow = 0
+	case key.CodeS:
+		SaveHallOfFameFile()
+	case key.CodeL:
+		if player == nil {
+			LoadHallOfFameFile()
+		}
+	case key.CodeZ:
+		camera.ZoomAt(camera.width/2, camera.height/2, zoomStep)
+	case key.CodeX:
+		camera.ZoomAt(camera.width/2, camera.height/2, 1/zoomStep)
+	case key.CodeF:
+		if camera.Follow == 0 {
+			camera.Follow = renderer.Inspector.Selected
+		} else {
+			camera.Follow = 0
+		}
+	case key.Code0:
+		camera.Reset()
+	}
+}
+
+// panKey pans the camera if code is an arrow key, returning false if not
+func panKey(code key.Code) bool {
+	camera := &renderer.Camera
+	switch code {
+	case key.CodeLeftArrow:
+		camera.Pan(panStep, 0)
+	case key.CodeRightArrow:
+		camera.Pan(-panStep, 0)
+	case key.CodeUpArrow:
+		camera.Pan(0, panStep)
+	case key.CodeDownArrow:
+		camera.Pan(0, -panStep)
+	default:
+		return false
+	}
+	return true
+}
+
+// SaveHallOfFameFile adds the genomes in the simulation's hall of fame to
+// the hall of fame file, which is trimmed like the simulation's hall of fame
+// so that it does not grow with every save
+func SaveHallOfFameFile() {
+	h, err := LoadHallOfFame(*hallOfFamePath)
+	if err != nil {
+		log.Printf("failed to save hall of fame: %v", err)
+		return
+	}
+	for _, g := range sim.HallOfFame() {
+		h.Add(g)
+	}
+	cfg := sim.Config()
+	h.Trim(cfg.hallOfFameSize(len(h.Genomes)))
+	if err := h.Save(*hallOfFamePath); err != nil {
+		log.Printf("failed to save hall of fame: %v", err)
+		return
+	}
+	log.Printf("saved hall of fame to %s", *hallOfFamePath)
+}
+
+// LoadHallOfFameFile adds the genomes in the hall of fame file to the
+// simulation's hall of fame
+func LoadHallOfFameFile() {
+	h, err := LoadHallOfFame(*hallOfFamePath)
+	if err != nil {
+		log.Printf("failed to load hall of fame: %v", err)
+		return
+	}
+	sim.ApplyInput(Input{Kind: InputLoadHallOfFame, Genomes: h.Genomes})
+	log.Printf("loaded %d genomes from %s", len(h.Genomes), *hallOfFamePath)
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Layout is the static geometry inside the simulation area of a scenario,
+// all of which is built from walls. Positions are in the simulation area.
+type Layout struct {
+	Walls     []WallSpec     `json:"walls,omitempty"`
+	Pillars   []PillarSpec   `json:"pillars,omitempty"`
+	Corridors []CorridorSpec `json:"corridors,omitempty"`
+	Mazes     []MazeSpec     `json:"mazes,omitempty"`
+}
+
+// WallSpec is a straight wall from (X1, Y1) to (X2, Y2), Width defaults to
+// the width of walls drawn by hand
+type WallSpec struct {
+	X1    float64 `json:"x1"`
+	Y1    float64 `json:"y1"`
+	X2    float64 `json:"x2"`
+	Y2    float64 `json:"y2"`
+	Width float64 `json:"width,omitempty"`
+}
+
+// PillarSpec is a round pillar centered on (X, Y)
+type PillarSpec struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Radius float64 `json:"radius"`
+}
+
+// CorridorSpec is a pair of walls either side of the line from (X1, Y1) to
+// (X2, Y2) leaving a passage Gap wide between them
+type CorridorSpec struct {
+	X1    float64 `json:"x1"`
+	Y1    float64 `json:"y1"`
+	X2    float64 `json:"x2"`
+	Y2    float64 `json:"y2"`
+	Gap   float64 `json:"gap"`
+	Width float64 `json:"width,omitempty"`
+}
+
+// MazeSpec is a maze of Cols by Rows square cells of size Cell with its top
+// left corner at (X, Y). Every cell is reachable from every other and from
+// the openings on the left of the top left cell and the right of the bottom
+// right cell, and the same Seed always gives the same maze.
+type MazeSpec struct {
+	X     float64 `json:"x"`
+	Y     float64 `json:"y"`
+	Cols  int     `json:"cols"`
+	Rows  int     `json:"rows"`
+	Cell  float64 `json:"cell"`
+	Seed  int64   `json:"seed"`
+	Width float64 `json:"width,omitempty"`
+	// Whether the maze has been turned sideways by transposing its layout,
+	// so that the openings are on the top and bottom instead
+	Transposed bool `json:"-"`
+}
+
+// wallSpecWidth returns width, or the width of walls drawn by hand if it is
+// zero
+func wallSpecWidth(width float64) float64 {
+	if width == 0 {
+		return wallWidth
+	}
+	return width
+}
+
+// validate returns an error naming the first field of l that is invalid
+// for c, or nil if l is valid
+func (l *Layout) validate(c *Config) error {
+	w, h := float64(c.Width), float64(c.Height)
+	inside := func(x, y float64) bool {
+		return x >= 0 && y >= 0 && x <= w && y <= h
+	}
+	for i, s := range l.Walls {
+		switch {
+		case !inside(s.X1, s.Y1) || !inside(s.X2, s.Y2):
+			return fmt.Errorf("config: layout.walls[%d]: the wall must be inside the %gx%g simulation area", i, w, h)
+		case s.Width < 0:
+			return fmt.Errorf("config: layout.walls[%d].width must not be negative", i)
+		}
+	}
+	for i, s := range l.Pillars {
+		switch {
+		case !inside(s.X, s.Y):
+			return fmt.Errorf("config: layout.pillars[%d]: the pillar must be inside the %gx%g simulation area", i, w, h)
+		case s.Radius <= 0:
+			return fmt.Errorf("config: layout.pillars[%d].radius must be positive", i)
+		}
+	}
+	for i, s := range l.Corridors {
+		switch {
+		case !inside(s.X1, s.Y1) || !inside(s.X2, s.Y2):
+			return fmt.Errorf("config: layout.corridors[%d]: the corridor must be inside the %gx%g simulation area", i, w, h)
+		case s.X1 == s.X2 && s.Y1 == s.Y2:
+			return fmt.Errorf("config: layout.corridors[%d]: the corridor must have a length", i)
+		case s.Gap <= 0:
+			return fmt.Errorf("config: layout.corridors[%d].gap must be positive", i)
+		case s.Width < 0:
+			return fmt.Errorf("config: layout.corridors[%d].width must not be negative", i)
+		}
+	}
+	for i, s := range l.Mazes {
+		switch {
+		case s.Cols < 1 || s.Rows < 1:
+			return fmt.Errorf("config: layout.mazes[%d]: cols and rows must be at least 1", i)
+		case s.Cell <= 0:
+			return fmt.Errorf("config: layout.mazes[%d].cell must be positive", i)
+		case !inside(s.X, s.Y) || !inside(s.X+float64(s.Cols)*s.Cell, s.Y+float64(s.Rows)*s.Cell):
+			return fmt.Errorf("config: layout.mazes[%d]: the maze must be inside the %gx%g simulation area", i, w, h)
+		case s.Width < 0:
+			return fmt.Errorf("config: layout.mazes[%d].width must not be negative", i)
+		}
+	}
+	return nil
+}
+
+// transpose swaps the x and y coordinates of everything in l, for swapping
+// the width and height of the simulation area
+func (l *Layout) transpose() {
+	// copy the slices so that other configs sharing them are unchanged
+	l.Walls = append([]WallSpec(nil), l.Walls...)
+	for i := range l.Walls {
+		s := &l.Walls[i]
+		s.X1, s.Y1, s.X2, s.Y2 = s.Y1, s.X1, s.Y2, s.X2
+	}
+	l.Pillars = append([]PillarSpec(nil), l.Pillars...)
+	for i := range l.Pillars {
+		s := &l.Pillars[i]
+		s.X, s.Y = s.Y, s.X
+	}
+	l.Corridors = append([]CorridorSpec(nil), l.Corridors...)
+	for i := range l.Corridors {
+		s := &l.Corridors[i]
+		s.X1, s.Y1, s.X2, s.Y2 = s.Y1, s.X1, s.Y2, s.X2
+	}
+	l.Mazes = append([]MazeSpec(nil), l.Mazes...)
+	for i := range l.Mazes {
+		s := &l.Mazes[i]
+		s.X, s.Y, s.Cols, s.Rows = s.Y, s.X, s.Rows, s.Cols
+		s.Transposed = !s.Transposed
+	}
+}
+
+// addLayout adds the walls making up l to the simulation
+func (s *Sim) addLayout(l *Layout) {
+	for _, w := range l.Walls {
+		s.addWall(w.X1, w.Y1, w.X2, w.Y2, wallSpecWidth(w.Width))
+	}
+	for _, p := range l.Pillars {
+		s.addWall(p.X, p.Y, p.X, p.Y, p.Radius*2)
+	}
+	for _, c := range l.Corridors {
+		width := wallSpecWidth(c.Width)
+		// offset the walls at right angles to the corridor so that
+		// their inner edges are gap apart
+		length := xyDist(c.X1, c.Y1, c.X2, c.Y2)
+		offset := (c.Gap + width) / 2
+		nx := -(c.Y2 - c.Y1) / length * offset
+		ny := (c.X2 - c.X1) / length * offset
+		s.addWall(c.X1+nx, c.Y1+ny, c.X2+nx, c.Y2+ny, width)
+		s.addWall(c.X1-nx, c.Y1-ny, c.X2-nx, c.Y2-ny, width)
+	}
+	for i := range l.Mazes {
+		s.addMaze(&l.Mazes[i])
+	}
+}
+
+// addMaze adds the walls of the maze m to the simulation. The maze is
+// carved out of a grid of closed cells with a randomized depth first search.
+func (s *Sim) addMaze(m *MazeSpec) {
+	// a transposed maze is carved on the grid it was given with and then
+	// its walls are turned sideways, so that it is the same maze
+	x0, y0, cols, rows := m.X, m.Y, m.Cols, m.Rows
+	if m.Transposed {
+		x0, y0, cols, rows = m.Y, m.X, m.Rows, m.Cols
+	}
+	width := wallSpecWidth(m.Width)
+	addWall := func(x1, y1, x2, y2 float64) {
+		if m.Transposed {
+			x1, y1, x2, y2 = y1, x1, y2, x2
+		}
+		s.addWall(x1, y1, x2, y2, width)
+	}
+	rng := rand.New(rand.NewSource(m.Seed))
+	// whether the wall on the right of and below each cell is open
+	right := make([]bool, cols*rows)
+	down := make([]bool, cols*rows)
+	visited := make([]bool, cols*rows)
+	stack := []int{0}
+	visited[0] = true
+	neighbors := make([]int, 0, 4)
+	for len(stack) > 0 {
+		cell := stack[len(stack)-1]
+		col, row := cell%cols, cell/cols
+		neighbors = neighbors[:0]
+		if col > 0 && !visited[cell-1] {
+			neighbors = append(neighbors, cell-1)
+		}
+		if col < cols-1 && !visited[cell+1] {
+			neighbors = append(neighbors, cell+1)
+		}
+		if row > 0 && !visited[cell-cols] {
+			neighbors = append(neighbors, cell-cols)
+		}
+		if row < rows-1 && !visited[cell+cols] {
+			neighbors = append(neighbors, cell+cols)
+		}
+		if len(neighbors) == 0 {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		next := neighbors[rng.Intn(len(neighbors))]
+		switch next {
+		case cell - 1:
+			right[next] = true
+		case cell + 1:
+			right[cell] = true
+		case cell - cols:
+			down[next] = true
+		case cell + cols:
+			down[cell] = true
+		}
+		visited[next] = true
+		stack = append(stack, next)
+	}
+	x1, y1 := x0, y0
+	x2, y2 := x0+float64(cols)*m.Cell, y0+float64(rows)*m.Cell
+	// the outside walls, leaving the openings
+	addWall(x1, y1, x2, y1)
+	addWall(x1, y1+m.Cell, x1, y2)
+	addWall(x2, y1, x2, y2-m.Cell)
+	addWall(x1, y2, x2, y2)
+	// the inside walls that were not carved away
+	for row := 0; row < rows; row++ {
+		for col := 0; col < cols; col++ {
+			cell := row*cols + col
+			x := x0 + float64(col)*m.Cell
+			y := y0 + float64(row)*m.Cell
+			if col < cols-1 && !right[cell] {
+				addWall(x+m.Cell, y, x+m.Cell, y+m.Cell)
+			}
+			if row < rows-1 && !down[cell] {
+				addWall(x, y+m.Cell, x+m.Cell, y+m.Cell)
+			}
+		}
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// emptySim returns a simulation with no walls
+func emptySim(t *testing.T) *Sim {
+	s := NewSim(DefaultConfig(), 1)
+	if len(s.walls) != 0 {
+		t.Fatalf("the default config has %d walls", len(s.walls))
+	}
+	return s
+}
+
+// blocked returns whether (x, y) is inside a wall of s
+func blocked(s *Sim, x, y float64) bool {
+	_, dist := s.nearestWall(x, y)
+	return dist == 0
+}
+
+func TestAddMaze(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		m := MazeSpec{X: 20, Y: 30, Cols: 7, Rows: 5, Cell: 40, Seed: seed}
+		s := emptySim(t)
+		s.addMaze(&m)
+		center := func(cell int) (x, y float64) {
+			return m.X + (float64(cell%m.Cols)+0.5)*m.Cell,
+				m.Y + (float64(cell/m.Cols)+0.5)*m.Cell
+		}
+		// cells are joined if the middle of the side between them is open
+		open := func(a, b int) bool {
+			ax, ay := center(a)
+			bx, by := center(b)
+			return !blocked(s, (ax+bx)/2, (ay+by)/2)
+		}
+		n := m.Cols * m.Rows
+		passages := 0
+		reached := make([]bool, n)
+		reached[0] = true
+		queue := []int{0}
+		for len(queue) > 0 {
+			cell := queue[0]
+			queue = queue[1:]
+			col, row := cell%m.Cols, cell/m.Cols
+			var neighbors []int
+			if col < m.Cols-1 {
+				neighbors = append(neighbors, cell+1)
+			}
+			if row < m.Rows-1 {
+				neighbors = append(neighbors, cell+m.Cols)
+			}
+			if col > 0 {
+				neighbors = append(neighbors, cell-1)
+			}
+			if row > 0 {
+				neighbors = append(neighbors, cell-m.Cols)
+			}
+			for _, next := range neighbors {
+				if !open(cell, next) {
+					continue
+				}
+				if next > cell {
+					passages++
+				}
+				if !reached[next] {
+					reached[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+		for cell := range reached {
+			if !reached[cell] {
+				t.Errorf("seed %d: cell %d is not reachable", seed, cell)
+			}
+		}
+		// a maze carved by a search is a tree, with no loops
+		if passages != n-1 {
+			t.Errorf("seed %d: %d passages between %d cells, expected %d",
+				seed, passages, n, n-1)
+		}
+
+		x1, y1 := m.X, m.Y
+		x2, y2 := m.X+float64(m.Cols)*m.Cell, m.Y+float64(m.Rows)*m.Cell
+		if blocked(s, x1, y1+m.Cell/2) {
+			t.Errorf("seed %d: the opening on the left of the top left cell is closed", seed)
+		}
+		if blocked(s, x2, y2-m.Cell/2) {
+			t.Errorf("seed %d: the opening on the right of the bottom right cell is closed", seed)
+		}
+		// everywhere else the outside is closed
+		for row := 1; row < m.Rows; row++ {
+			if !blocked(s, x1, y1+(float64(row)+0.5)*m.Cell) {
+				t.Errorf("seed %d: the left of row %d is open", seed, row)
+			}
+			if !blocked(s, x2, y1+(float64(row)-0.5)*m.Cell) {
+				t.Errorf("seed %d: the right of row %d is open", seed, row-1)
+			}
+		}
+		for col := 0; col < m.Cols; col++ {
+			x := x1 + (float64(col)+0.5)*m.Cell
+			if !blocked(s, x, y1) || !blocked(s, x, y2) {
+				t.Errorf("seed %d: the top or bottom of column %d is open", seed, col)
+			}
+		}
+
+		// the same seed gives the same maze
+		again := emptySim(t)
+		again.addMaze(&m)
+		if !reflect.DeepEqual(s.walls, again.walls) {
+			t.Errorf("seed %d: the maze is not the same for the same seed", seed)
+		}
+	}
+}
+
+func TestAddMazeTransposed(t *testing.T) {
+	for seed := int64(1); seed <= 5; seed++ {
+		l := Layout{Mazes: []MazeSpec{{X: 20, Y: 30, Cols: 7, Rows: 5, Cell: 40, Seed: seed}}}
+		s := emptySim(t)
+		s.addLayout(&l)
+		l.transpose()
+		transposed := emptySim(t)
+		transposed.addLayout(&l)
+		// the maze is turned sideways, not carved again on the new grid
+		want := append([]Wall(nil), s.walls...)
+		for i := range want {
+			w := &want[i]
+			w.x1, w.y1, w.x2, w.y2 = w.y1, w.x1, w.y2, w.x2
+		}
+		if !reflect.DeepEqual(transposed.walls, want) {
+			t.Errorf("seed %d: the walls of the transposed maze are not the transposed walls", seed)
+		}
+	}
+}
+
+func TestAddLayoutCorridor(t *testing.T) {
+	c := CorridorSpec{X1: 100, Y1: 300, X2: 250, Y2: 100, Gap: 30, Width: 4}
+	s := emptySim(t)
+	s.addLayout(&Layout{Corridors: []CorridorSpec{c}})
+	if len(s.walls) != 2 {
+		t.Fatalf("got %d walls for a corridor, expected 2", len(s.walls))
+	}
+	a, b := &s.walls[0], &s.walls[1]
+	const epsilon = 1e-9
+	dx, dy := c.X2-c.X1, c.Y2-c.Y1
+	for _, w := range []*Wall{a, b} {
+		if w.width != c.Width {
+			t.Errorf("got wall width %g, expected %g", w.width, c.Width)
+		}
+		// each wall is parallel to and as long as the corridor
+		if math.Abs(w.x2-w.x1-dx) > epsilon || math.Abs(w.y2-w.y1-dy) > epsilon {
+			t.Errorf("wall (%g, %g)-(%g, %g) is not parallel to the corridor",
+				w.x1, w.y1, w.x2, w.y2)
+		}
+	}
+	// the walls are either side of the line at right angles to it
+	if math.Abs((a.x1+b.x1)/2-c.X1) > epsilon || math.Abs((a.y1+b.y1)/2-c.Y1) > epsilon {
+		t.Errorf("the walls are not centered on the corridor")
+	}
+	if dot := (a.x1-b.x1)*dx + (a.y1-b.y1)*dy; math.Abs(dot) > epsilon {
+		t.Errorf("the walls are not offset at right angles to the corridor")
+	}
+	// so that their inner edges are the gap apart
+	if d := xyDist(a.x1, a.y1, b.x1, b.y1); math.Abs(d-(c.Gap+c.Width)) > epsilon {
+		t.Errorf("the walls are %g apart, expected %g", d, c.Gap+c.Width)
+	}
+	mx, my := (c.X1+c.X2)/2, (c.Y1+c.Y2)/2
+	for _, w := range []*Wall{a, b} {
+		d := wallEdgeDist(mx, my, w.x1, w.y1, w.x2, w.y2, w.width)
+		if math.Abs(d-c.Gap/2) > epsilon {
+			t.Errorf("the middle of the corridor is %g from a wall, expected %g", d, c.Gap/2)
+		}
+	}
+}
+
+func TestLayoutTranspose(t *testing.T) {
+	l := Layout{
+		Walls:     []WallSpec{{X1: 1, Y1: 2, X2: 3, Y2: 4, Width: 5}},
+		Pillars:   []PillarSpec{{X: 1, Y: 2, Radius: 3}},
+		Corridors: []CorridorSpec{{X1: 1, Y1: 2, X2: 3, Y2: 4, Gap: 5, Width: 6}},
+		Mazes:     []MazeSpec{{X: 1, Y: 2, Cols: 3, Rows: 4, Cell: 5, Seed: 6, Width: 7}},
+	}
+	orig := l
+	want := Layout{
+		Walls:     []WallSpec{{X1: 2, Y1: 1, X2: 4, Y2: 3, Width: 5}},
+		Pillars:   []PillarSpec{{X: 2, Y: 1, Radius: 3}},
+		Corridors: []CorridorSpec{{X1: 2, Y1: 1, X2: 4, Y2: 3, Gap: 5, Width: 6}},
+		Mazes:     []MazeSpec{{X: 2, Y: 1, Cols: 4, Rows: 3, Cell: 5, Seed: 6, Width: 7, Transposed: true}},
+	}
+	l.transpose()
+	if !reflect.DeepEqual(l, want) {
+		t.Errorf("got %+v, expected %+v", l, want)
+	}
+	// the layout it was copied from shares the slices and is unchanged
+	if orig.Walls[0].X1 != 1 || orig.Pillars[0].X != 1 || orig.Corridors[0].X1 != 1 ||
+		orig.Mazes[0].Cols != 3 {
+		t.Errorf("transpose changed the original layout %+v", orig)
+	}
+	l.transpose()
+	if !reflect.DeepEqual(l, orig) {
+		t.Errorf("transposing twice got %+v, expected %+v", l, orig)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"time"
+)
+
+// FixedStep runs a simulation at a fixed number of ticks per second,
+// independent of how often frames are drawn, by accumulating the time
+// elapsed between frames and running a tick for each whole tick period.
+type FixedStep struct {
+	// TicksPerSecond is the simulation rate, if it is zero as many ticks
+	// as fit in Budget are run each frame
+	TicksPerSecond float64
+	// Budget is the most time to spend running ticks each frame. If the
+	// ticks due take longer the simulation slows down instead of falling
+	// further and further behind.
+	Budget time.Duration
+	last   time.Time
+	acc    time.Duration // time elapsed but not yet simulated
+}
+
+// Advance runs the ticks due at now by calling step, which may return false
+// to stop early. Advance returns the fraction of a tick period elapsed since
+// the last tick for interpolating, which is 1 if running as fast as possible.
+func (f *FixedStep) Advance(now time.Time, step func() bool) float64 {
+	if f.last.IsZero() {
+		f.last = now
+	}
+	elapsed := now.Sub(f.last)
+	f.last = now
+	if f.TicksPerSecond <= 0 {
+		f.acc = 0
+		for start := time.Now(); time.Since(start) < f.Budget && step(); {
+		}
+		return 1
+	}
+	period := time.Duration(float64(time.Second) / f.TicksPerSecond)
+	f.acc += elapsed
+	for start := time.Now(); f.acc >= period; f.acc -= period {
+		if time.Since(start) >= f.Budget {
+			// drop the backlog
+			f.acc = 0
+			break
+		}
+		if !step() {
+			f.acc = 0
+			break
+		}
+	}
+	return float64(f.acc) / float64(period)
+}
+
+// Reset forgets the time elapsed so far, such as while paused, so that the
+// next call to Advance starts from scratch
+func (f *FixedStep) Reset() {
+	f.last = time.Time{}
+	f.acc = 0
+}
+
+// frameInterval is the shortest time between frames on desktop platforms,
+// where publishing a frame may not wait for the display
+const frameInterval = time.Second / 60
+
+var (
+	clock      FixedStep    // runs the simulation in app mode
+	interp     Interpolator // the simulation's renderer in app mode
+	frameState WorldState   // the interpolated state drawn each frame
+	lastFrame  time.Time
+)
+
+// AdvanceSim handles the gestures that are due, runs the simulation for a
+// frame at the -tps rate times the speed, or by the ticks requested while
+// paused, and draws the frame
+func AdvanceSim() {
+	UpdateGestures()
+	now := time.Now()
+	alpha := 1.0
+	switch {
+	case !paused:
+		clock.TicksPerSecond = *ticksPerSecond * float64(speed)
+		alpha = clock.Advance(now, StepSim)
+	case steps > 0:
+		StepSim()
+		steps--
+	}
+	if paused {
+		clock.Reset()
+	}
+	interp.State(alpha, &frameState)
+	renderer.Render(&frameState)
+}
+
+// LimitFrameRate sleeps until frameInterval has passed since the last frame
+// on platforms where publishing frames does not wait for the display
+func LimitFrameRate() {
+	if !onAndroid && !(onDarwin && onArm) {
+		if d := frameInterval - time.Since(lastFrame); d > 0 {
+			time.Sleep(d)
+		}
+	}
+	lastFrame = time.Now()
+}
@@ -17,14 +17,20 @@ limitations under the License.
 package main
 
 import (
+	"flag"
 	"image"
 	"image/draw"
+	"log"
+	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"time"
 
 	"golang.org/x/mobile/app"
+	"golang.org/x/mobile/event/key"
 	"golang.org/x/mobile/event/lifecycle"
+	"golang.org/x/mobile/event/mouse"
 	"golang.org/x/mobile/event/paint"
 	"golang.org/x/mobile/event/size"
 	"golang.org/x/mobile/event/touch"
@@ -34,51 +40,210 @@ import (
 )
 
 var (
-	glctx     gl.Context     // opengl context
-	images    *glutil.Images // opengl textures manager
-	img       *glutil.Image  // opengl texture
-	sz        *size.Event    // for tracking the window size
-	sim       *Sim           // the simulation
-	onAndroid bool           // true if we are running on android
-	onArm     bool           // true if we are running on arm
-	onDarwin  bool           // true if we are running on darwin
+	glctx     gl.Context      // opengl context
+	images    *glutil.Images  // opengl textures manager
+	img       *glutil.Image   // opengl texture
+	sz        *size.Event     // for tracking the window size
+	sim       *Sim            // the simulation
+	onAndroid bool            // true if we are running on android
+	onArm     bool            // true if we are running on arm
+	onDarwin  bool            // true if we are running on darwin
+	metrics   *Metrics        // prometheus metrics, nil if not enabled
+	deathLog  *DeathLog       // log of creature deaths, nil if not enabled
+	recorder  *ReplayRecorder // replay recording, nil if not enabled
+	player    *ReplayPlayer   // replay playback, nil if not replaying
+	exporter  *FrameExporter  // frame export, nil if not enabled
+	renderer  *RasterRenderer // draws the frames, nil if headless
+	runner    *SimRunner      // runs the simulation in the app
 )
 
+var (
+	headless       = flag.Bool("headless", false, "run the simulation without a window")
+	numTicks       = flag.Int("ticks", 0, "number of ticks to run when headless, 0 runs forever")
+	metricsAddr    = flag.String("metrics", "", "serve prometheus metrics at /metrics on this address, e.g. localhost:9090")
+	deathLogPath   = flag.String("deathlog", "", "write a JSON record of each creature death to this file")
+	seed           = flag.Int64("seed", 0, "seed for the simulation, 0 picks a random seed")
+	recordPath     = flag.String("record", "", "record a replay of the run to this file")
+	replayPath     = flag.String("replay", "", "play back the replay recorded in this file")
+	verify         = flag.Bool("verify", false, "fail if the replay diverges from the recording")
+	exportPath     = flag.String("export", "", "export frames to this file, or directory for a png sequence")
+	exportFormat   = flag.String("export-format", "", "export format: gif, apng or png, defaults to the -export extension")
+	exportStride   = flag.Int("export-stride", 1, "export every n-th frame")
+	exportFPS      = flag.Int("export-fps", 30, "frames per second of exported animations")
+	snapshotPath   = flag.String("snapshot", "", "save an svg or pdf snapshot of the last frame to this file on exit")
+	snapshotScale  = flag.Float64("snapshot-scale", 1, "scale of the snapshot relative to the frame size")
+	hallOfFamePath = flag.String("halloffame", "halloffame.json", "hall of fame file genomes are saved to and loaded from")
+	ticksPerSecond = flag.Float64("tps", 30, "simulation ticks per second in the app, 0 runs as fast as possible")
+	overlay        = flag.Bool("overlay", false, "draw the sensor ray and brain state debug overlay")
+	hud            = flag.Bool("hud", true, "draw the statistics HUD in the app")
+	charts         = flag.Bool("charts", true, "draw charts of the hall of fame scores and population diversity in the app")
+	configPath     = flag.String("config", "", "load the simulation configuration from this JSON file, flags override it")
+	scenario       = flag.String("scenario", "", "load a built-in scenario by name, or a scenario file like -config")
+	landscape      = flag.Bool("landscape", false, "swap the width and height of the simulation area")
+	fitWindow      = flag.Bool("fit", false, "resize the simulation area to fill the window, always on for android")
+	statePathFlag  = flag.String("state", "", "save the hall of fame to this file when the app stops and restore it at start, defaults to the app's files directory on android")
+)
+
+// flagConfig holds the values of the simulation configuration flags
+var flagConfig = DefaultConfig()
+
 func init() {
 	// initialize platform detection booleans
 	onAndroid = (runtime.GOOS == "android")
 	onArm = (strings.HasPrefix(runtime.GOARCH, "arm"))
 	onDarwin = (runtime.GOOS == "darwin")
+	flagConfig.RegisterFlags(flag.CommandLine)
 }
 
 func main() {
-	// width and height of the simulation area.
-	// this seems to be plenty and smaller areas will be cheaper
-	// to run especially on mobile.
-	width := 405
-	height := 720
-	// complementary border thickness
-	borderWidth := 16
-	sim = NewSim(width, height, borderWidth)
+	flag.Parse()
+	// the working directory is not writable on android
+	if dir := filesDir(); dir != "" && !filepath.IsAbs(*hallOfFamePath) {
+		*hallOfFamePath = filepath.Join(dir, *hallOfFamePath)
+	}
+	// the simulation configuration is the defaults, overridden by the
+	// configuration file and then by flags
+	cfg := DefaultConfig()
+	if *scenario != "" && *configPath != "" {
+		log.Fatal("only one of -scenario and -config may be set")
+	}
+	if *scenario != "" {
+		var err error
+		if cfg, err = LoadScenario(*scenario); err != nil {
+			log.Fatal(err)
+		}
+	} else if *configPath != "" {
+		var err error
+		if cfg, err = LoadConfig(*configPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+	cfg.ApplyFlags(flag.CommandLine, &flagConfig)
+	if *landscape {
+		cfg.transpose()
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatal(err)
+	}
+	if flag.Arg(0) == "sweep" {
+		if err := RunSweep(cfg, flag.Args()[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	// flags are not passed on android, where the screen may rotate
+	if onAndroid {
+		*fitWindow = true
+	}
+	// exported animations need frames of a single size
+	if *fitWindow && *exportPath != "" {
+		log.Print("not resizing the simulation to the window while exporting")
+		*fitWindow = false
+	}
+	if *replayPath != "" {
+		f, err := os.Open(*replayPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		player, err = NewReplayPlayer(f, *verify)
+		if err != nil {
+			log.Fatal(err)
+		}
+		sim = player.Sim()
+	} else {
+		if *seed == 0 {
+			*seed = time.Now().UnixNano()
+		}
+		sim = NewSim(cfg, *seed)
+	}
+	if *recordPath != "" {
+		f, err := os.Create(*recordPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		recorder, err = NewReplayRecorder(f, sim, replayChecksumTicks)
+		if err != nil {
+			log.Fatal(err)
+		}
+		sim.AddObserver(recorder)
+	}
+	if *metricsAddr != "" {
+		metrics = NewMetrics()
+		sim.AddObserver(metrics)
+		go func() {
+			log.Fatal(ServeMetrics(*metricsAddr, metrics))
+		}()
+	}
+	if *deathLogPath != "" {
+		f, err := os.Create(*deathLogPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		deathLog = NewDeathLog(f)
+		sim.AddObserver(deathLog)
+	}
+	if *exportPath != "" {
+		w, err := NewFrameWriter(*exportPath, *exportFormat, *exportFPS)
+		if err != nil {
+			log.Fatal(err)
+		}
+		r := NewRasterRenderer(sim.FrameBounds())
+		r.Overlay.Enabled = *overlay
+		exporter = NewFrameExporter(w, r, *exportStride)
+		sim.AddObserver(exporter)
+	}
+	if *headless {
+		RunHeadless(*numTicks)
+		finish()
+		return
+	}
+	// the simulation keeps the last states for drawing frames between
+	// ticks, which are drawn by renderer
+	sim.SetRenderer(&interp)
+	interp.Render(sim.State())
+	renderer = NewRasterRenderer(sim.FrameBounds())
+	renderer.Overlay.Enabled = *overlay
+	renderer.HUD.Enabled = *hud
+	renderer.Charts.Enabled = *charts
+	renderer.Charts.History = &History{}
+	sim.AddObserver(renderer.Charts.History)
+	clock.Budget = frameInterval
+	runner = NewSimRunner(sim.FrameBounds())
+	RestoreState()
 	app.Main(func(a app.App) {
 		for e := range a.Events() {
 			switch e := a.Filter(e).(type) {
 			case lifecycle.Event:
-				switch e.Crosses(lifecycle.StageVisible) {
-				case lifecycle.CrossOn:
+				// a single event may cross several stages, such as when
+				// a desktop window opens or closes
+				if e.Crosses(lifecycle.StageVisible) == lifecycle.CrossOn {
 					// we want all OpenGL calls to be on this thread,
 					// so lock it.
 					runtime.LockOSThread()
 					glctx, _ = e.DrawContext.(gl.Context)
 					images = glutil.NewImages(glctx)
 					// get sim buffer size
-					simBounds := sim.CurrentFrame.Bounds()
+					simBounds := runner.Frames.Front().Bounds()
 					// create an image for uploading the sim
 					// frames to an opengl texture
 					img = images.NewImage(simBounds.Dx(), simBounds.Dy())
 					// start rendering
 					a.Send(paint.Event{})
+				}
+				// only simulate while focused, and save the state when
+				// pausing in case the app is killed in the background
+				switch e.Crosses(lifecycle.StageFocused) {
+				case lifecycle.CrossOn:
+					runner.Start()
 				case lifecycle.CrossOff:
+					runner.Stop()
+					ResetTouches()
+					SaveState()
+				}
+				if e.Crosses(lifecycle.StageVisible) == lifecycle.CrossOff {
 					// release resources
 					img.Release()
 					images.Release()
@@ -94,50 +259,151 @@ func main() {
 			case size.Event:
 				// store for tracking app size and dpi
 				sz = &e
+				// draw the view to fit the window
+				view := viewSize(e)
+				runner.Do(func() {
+					renderer.ViewSize = view
+					if *fitWindow && player == nil {
+						FitArena(view)
+					}
+				})
 			case touch.Event:
-				// if the user clicks the screen, spawn a
-				// random creature.
-				if e.Type == touch.TypeBegin {
-					sim.SpawnRandomCreature()
+				if img == nil {
+					continue
+				}
+				// the handlers run on the simulation goroutine
+				seq := e.Sequence
+				vx, vy := screenToView(e.X, e.Y)
+				switch e.Type {
+				case touch.TypeBegin:
+					runner.Do(func() { TouchBegin(seq, vx, vy) })
+				case touch.TypeMove:
+					runner.Do(func() { TouchMove(seq, vx, vy) })
+				case touch.TypeEnd:
+					runner.Do(func() { TouchEnd(seq, vx, vy) })
+				}
+			case mouse.Event:
+				// scrolling zooms where the platform reports it
+				if img == nil {
+					continue
+				}
+				factor := 0.0
+				switch e.Button {
+				case mouse.ButtonWheelUp:
+					factor = zoomStep
+				case mouse.ButtonWheelDown:
+					factor = 1 / zoomStep
+				default:
+					continue
 				}
+				vx, vy := screenToView(e.X, e.Y)
+				runner.Do(func() { renderer.Camera.ZoomAt(vx, vy, factor) })
+			case key.Event:
+				runner.Do(func() { HandleKey(e) })
 			case paint.Event:
 				// can't draw if opengl context doesnt exist.
 				if glctx == nil {
 					continue
 				}
-				// update sim
-				sim.DoTick()
-				// draw to screen
+				// draw the latest frame to screen
 				Draw()
 				// tell the mobile package we're done
 				a.Publish()
 				// keep updating
 				a.Send(paint.Event{})
-				// TODO: Ugly Hack, rate-limit on desktop
-				if !onAndroid && !(onDarwin && onArm) {
-					time.Sleep(time.Millisecond * 30)
-				}
+				LimitFrameRate()
 			}
 		}
 	})
+	runner.Stop()
+	finish()
 }
 
-// Draw draws the current simulation frame to the screen
-func Draw() {
-	// don't bother drawing if we have a zero dimension
-	if sz.WidthPx == 0 || sz.HeightPx == 0 {
-		return
+// StepSim runs the simulation by a single tick, or by the next tick of the
+// replay if one is being played. StepSim returns false once a replay ends.
+func StepSim() bool {
+	if player == nil {
+		sim.DoTick()
+		return true
+	}
+	done, err := player.Step()
+	if err != nil {
+		log.Fatal(err)
+	}
+	return !done
+}
+
+// RunHeadless runs the simulation for n ticks without a window,
+// if n is 0 the simulation runs forever or until the replay ends
+func RunHeadless(n int) {
+	for i := 0; (n == 0 || i < n) && StepSim(); i++ {
 	}
-	// on android in particular we need to avoid the status bar
-	var topOffset float32
+	if player != nil && *verify {
+		log.Printf("replay verified through tick %d", sim.tickCounter)
+	}
+}
+
+// finish flushes the replay recording and frame export and reports any
+// logging errors before the program exits
+func finish() {
+	if *snapshotPath != "" {
+		if err := SaveSnapshot(*snapshotPath, sim.State(), *snapshotScale); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if exporter != nil {
+		if err := exporter.Close(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if recorder != nil {
+		if err := recorder.Close(sim); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if deathLog != nil && deathLog.Err() != nil {
+		log.Fatal(deathLog.Err())
+	}
+}
+
+// maxViewSize is the largest width or height of the view drawn in the app
+const maxViewSize = 1024
+
+// statusBarHeight is the height in pixels of the android status bar
+const statusBarHeight = 60
+
+// statusBarOffset returns the height in points at the top of the screen to
+// leave empty, on android in particular we need to avoid the status bar
+func statusBarOffset() float32 {
 	if onAndroid {
-		topOffset = float32(60) / sz.PixelsPerPt
-	} else {
-		topOffset = 0
+		return float32(statusBarHeight) / sz.PixelsPerPt
 	}
-	// clear gl context
-	glctx.ClearColor(0, 0, 0, 1)
-	glctx.Clear(gl.COLOR_BUFFER_BIT)
+	return 0
+}
+
+// viewSize returns the size to draw the view at for the window size e, the
+// size of the window below the status bar scaled down by a whole factor to
+// at most maxViewSize, as drawing at the full resolution of a phone's
+// screen is too slow
+func viewSize(e size.Event) image.Point {
+	w, h := e.WidthPx, e.HeightPx
+	if onAndroid {
+		h -= statusBarHeight
+	}
+	if w <= 0 || h <= 0 {
+		return image.Point{}
+	}
+	n := 1
+	for w/n > maxViewSize || h/n > maxViewSize {
+		n++
+	}
+	return image.Pt(w/n, h/n)
+}
+
+// viewRect returns the top left corner and size in points of the area the
+// view is drawn to, letter boxed to fit the screen
+func viewRect() (origin geom.Point, wpt, hpt geom.Pt) {
+	topOffset := statusBarOffset()
 	// determine letter boxing
 	widthf := float32(img.RGBA.Bounds().Dx())
 	heightf := float32(img.RGBA.Bounds().Dy())
@@ -145,8 +411,6 @@ func Draw() {
 	heightfSpace := float32(sz.HeightPt) - topOffset
 	ratioW := widthfSpace / widthf
 	ratioH := (heightfSpace) / heightf
-	var wpt geom.Pt
-	var hpt geom.Pt
 	if ratioW < ratioH {
 		wpt = geom.Pt(widthf * ratioW)
 		hpt = geom.Pt(heightf * ratioW)
@@ -156,12 +420,70 @@ func Draw() {
 	}
 	widthBorder := (geom.Pt(widthfSpace) - wpt) / 2
 	heightBorder := (geom.Pt(heightfSpace)-hpt)/2 + geom.Pt(topOffset)
+	return geom.Point{widthBorder, heightBorder}, wpt, hpt
+}
+
+// screenToView converts the screen position x, y in pixels, as reported by
+// touch events, to a position in the view
+func screenToView(x, y float32) (vx, vy float64) {
+	origin, wpt, hpt := viewRect()
+	bounds := img.RGBA.Bounds()
+	vx = float64((x/sz.PixelsPerPt - float32(origin.X)) / float32(wpt) * float32(bounds.Dx()))
+	vy = float64((y/sz.PixelsPerPt - float32(origin.Y)) / float32(hpt) * float32(bounds.Dy()))
+	return vx, vy
+}
+
+// FitArena resizes the simulation area so that the frame fills a view of
+// size view, keeping the border thickness
+func FitArena(view image.Point) {
+	cfg := sim.Config()
+	w, h := view.X-2*cfg.BorderWidth, view.Y-2*cfg.BorderWidth
+	if w < cfg.minArenaSize() || h < cfg.minArenaSize() {
+		return
+	}
+	if s := sim.State(); w == s.Width && h == s.Height {
+		return
+	}
+	sim.ApplyInput(Input{Kind: InputResize, X: float64(w), Y: float64(h)})
+}
+
+// SaveSelectedGenome adds the genome of the inspected creature to the hall
+// of fame file
+func SaveSelectedGenome() {
+	g, ok := sim.CreatureGenome(renderer.Inspector.Selected)
+	if !ok {
+		return
+	}
+	if err := SaveGenome(*hallOfFamePath, g); err != nil {
+		log.Printf("failed to save genome: %v", err)
+		return
+	}
+	renderer.Inspector.Saved = g.GenomeID
+	log.Printf("saved genome %d to %s", g.GenomeID, *hallOfFamePath)
+}
+
+// Draw draws the current simulation frame to the screen
+func Draw() {
+	// don't bother drawing if we have a zero dimension
+	if sz.WidthPx == 0 || sz.HeightPx == 0 {
+		return
+	}
+	// clear gl context
+	glctx.ClearColor(0, 0, 0, 1)
+	glctx.Clear(gl.COLOR_BUFFER_BIT)
+	// reallocate the texture when the view size changes
+	frame := runner.Frames.Front()
+	if bounds := frame.Bounds(); bounds != img.RGBA.Bounds() {
+		img.Release()
+		img = images.NewImage(bounds.Dx(), bounds.Dy())
+	}
+	origin, wpt, hpt := viewRect()
 	// copy current simulation frame to opengl texture and display
-	draw.Draw(img.RGBA, img.RGBA.Bounds(), sim.CurrentFrame, image.ZP, draw.Src)
+	draw.Draw(img.RGBA, img.RGBA.Bounds(), frame, image.ZP, draw.Src)
 	img.Upload()
 	img.Draw(*sz,
-		geom.Point{widthBorder, heightBorder},
-		geom.Point{widthBorder + wpt, heightBorder},
-		geom.Point{widthBorder, heightBorder + hpt},
+		origin,
+		geom.Point{origin.X + wpt, origin.Y},
+		geom.Point{origin.X, origin.Y + hpt},
 		img.RGBA.Bounds())
 }
@@ -0,0 +1,128 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Metrics holds a snapshot of simulation statistics and serves them over
+// http in the Prometheus text exposition format.
+//
+// The Sim is not safe for concurrent use, so the snapshot is taken by
+// calling Update from the goroutine running the Sim after each tick, which
+// happens automatically if the Metrics is registered with Sim.AddObserver,
+// while ServeHTTP may be called from any goroutine.
+type Metrics struct {
+	BaseObserver
+	mu             sync.Mutex
+	ticks          int64
+	creatures      int
+	creaturePool   int
+	obstacles      int
+	bestCreatures  int
+	bestScore      int64
+	level          int
+	deathCounts    [numDeathCauses]int64
+	ticksPerSecond float64
+	// the start of the current ticks per second measurement window
+	rateStart time.Time
+	rateTicks int64
+}
+
+// NewMetrics returns a new Metrics with an empty snapshot
+func NewMetrics() *Metrics {
+	return &Metrics{
+		rateStart: time.Now(),
+	}
+}
+
+// Update snapshots the current state of the simulation.
+// WARNING: Update must not be called concurrently with Sim.DoTick
+func (m *Metrics) Update(s *Sim) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ticks = int64(s.tickCounter)
+	m.creatures = len(s.creatures)
+	m.creaturePool = len(s.creaturePool)
+	m.obstacles = len(s.obstacles)
+	m.bestCreatures = len(s.bestCreatures)
+	if len(s.bestCreatures) > 0 {
+		m.bestScore = s.bestCreatures[0].score
+	}
+	m.level = s.level
+	m.deathCounts = s.deathCounts
+	// recompute the tick rate about once a second
+	now := time.Now()
+	elapsed := now.Sub(m.rateStart)
+	if elapsed >= time.Second {
+		m.ticksPerSecond = float64(m.ticks-m.rateTicks) / elapsed.Seconds()
+		m.rateStart = now
+		m.rateTicks = m.ticks
+	}
+}
+
+// OnTick implements Observer by calling Update
+func (m *Metrics) OnTick(s *Sim) {
+	m.Update(s)
+}
+
+// writeMetric writes a single unlabeled metric with HELP and TYPE comments
+func writeMetric(w io.Writer, name, kind, help string, value interface{}) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n%s %v\n",
+		name, help, name, kind, name, value)
+}
+
+// ServeHTTP writes the last snapshot in the Prometheus text format
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	writeMetric(w, "creaturebox_ticks_total", "counter",
+		"Number of simulation ticks run.", m.ticks)
+	writeMetric(w, "creaturebox_ticks_per_second", "gauge",
+		"Simulation ticks run per second.", m.ticksPerSecond)
+	writeMetric(w, "creaturebox_creatures", "gauge",
+		"Number of live creatures.", m.creatures)
+	writeMetric(w, "creaturebox_creature_pool", "gauge",
+		"Number of dead creatures held for recycling.", m.creaturePool)
+	writeMetric(w, "creaturebox_obstacles", "gauge",
+		"Number of moving obstacles.", m.obstacles)
+	writeMetric(w, "creaturebox_hall_of_fame", "gauge",
+		"Number of creatures in the hall of fame.", m.bestCreatures)
+	writeMetric(w, "creaturebox_hall_of_fame_best_score", "gauge",
+		"Best score in the hall of fame.", m.bestScore)
+	writeMetric(w, "creaturebox_difficulty_level", "gauge",
+		"Difficulty level of the curriculum.", m.level)
+	fmt.Fprint(w, "# HELP creaturebox_deaths_total Number of creature deaths by cause.\n")
+	fmt.Fprint(w, "# TYPE creaturebox_deaths_total counter\n")
+	for cause := DeathCause(0); cause < numDeathCauses; cause++ {
+		fmt.Fprintf(w, "creaturebox_deaths_total{cause=%q} %d\n",
+			cause.String(), m.deathCounts[cause])
+	}
+}
+
+// ServeMetrics serves m at /metrics on addr, blocking until the server fails
+func ServeMetrics(addr string, m *Metrics) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m)
+	return http.ListenAndServe(addr, mux)
+}
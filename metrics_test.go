@@ -0,0 +1,103 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMetricsExposition(t *testing.T) {
+	m := &Metrics{
+		ticks:          1234,
+		creatures:      20,
+		creaturePool:   3,
+		obstacles:      5,
+		bestCreatures:  41,
+		bestScore:      987,
+		level:          2,
+		ticksPerSecond: 30.5,
+	}
+	m.deathCounts[DeathByBorder] = 7
+	m.deathCounts[DeathByObstacle] = 11
+	m.deathCounts[DeathByUser] = 1
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	if got, want := w.Header().Get("Content-Type"), "text/plain; version=0.0.4"; got != want {
+		t.Errorf("Content-Type %q, want %q", got, want)
+	}
+	want := `# HELP creaturebox_ticks_total Number of simulation ticks run.
+# TYPE creaturebox_ticks_total counter
+creaturebox_ticks_total 1234
+# HELP creaturebox_ticks_per_second Simulation ticks run per second.
+# TYPE creaturebox_ticks_per_second gauge
+creaturebox_ticks_per_second 30.5
+# HELP creaturebox_creatures Number of live creatures.
+# TYPE creaturebox_creatures gauge
+creaturebox_creatures 20
+# HELP creaturebox_creature_pool Number of dead creatures held for recycling.
+# TYPE creaturebox_creature_pool gauge
+creaturebox_creature_pool 3
+# HELP creaturebox_obstacles Number of moving obstacles.
+# TYPE creaturebox_obstacles gauge
+creaturebox_obstacles 5
+# HELP creaturebox_hall_of_fame Number of creatures in the hall of fame.
+# TYPE creaturebox_hall_of_fame gauge
+creaturebox_hall_of_fame 41
+# HELP creaturebox_hall_of_fame_best_score Best score in the hall of fame.
+# TYPE creaturebox_hall_of_fame_best_score gauge
+creaturebox_hall_of_fame_best_score 987
+# HELP creaturebox_difficulty_level Difficulty level of the curriculum.
+# TYPE creaturebox_difficulty_level gauge
+creaturebox_difficulty_level 2
+# HELP creaturebox_deaths_total Number of creature deaths by cause.
+# TYPE creaturebox_deaths_total counter
+creaturebox_deaths_total{cause="border"} 7
+creaturebox_deaths_total{cause="obstacle"} 11
+creaturebox_deaths_total{cause="wall"} 0
+creaturebox_deaths_total{cause="user"} 1
+creaturebox_deaths_total{cause="episode"} 0
+`
+	if got := w.Body.String(); got != want {
+		t.Errorf("served:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestMetricsUpdate(t *testing.T) {
+	s := NewSim(DefaultConfig(), 1)
+	m := NewMetrics()
+	for i := 0; i < 20; i++ {
+		s.DoTick()
+		m.Update(s)
+	}
+	if m.ticks != 20 || m.creatures != len(s.creatures) ||
+		m.creaturePool != len(s.creaturePool) || m.obstacles != len(s.obstacles) ||
+		m.bestCreatures != len(s.bestCreatures) || m.bestScore != s.bestCreatures[0].score ||
+		m.deathCounts != s.deathCounts {
+		t.Errorf("snapshot %+v does not match the simulation", m)
+	}
+	// the tick rate is measured over windows of at least a second
+	m.rateStart = time.Now().Add(-2 * time.Second)
+	m.rateTicks = -30
+	s.DoTick()
+	m.Update(s)
+	// 51 ticks in a little over 2 seconds
+	if m.ticksPerSecond > 25.5 || m.ticksPerSecond < 20 {
+		t.Errorf("%v ticks per second, want just under 25.5", m.ticksPerSecond)
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// ObstacleMotion is the way an obstacle moves around the simulation area
+type ObstacleMotion int
+
+const (
+	// MotionDrift moves in a straight line until the obstacle leaves the
+	// area and is replaced
+	MotionDrift ObstacleMotion = iota
+	// MotionBounce moves in a straight line bouncing off the area's edges
+	MotionBounce
+	// MotionSine drifts while swaying from side to side
+	MotionSine
+	// MotionPatrol moves between waypoints in the area in a loop
+	MotionPatrol
+	// MotionChase moves towards the nearest creature
+	MotionChase
+	// numObstacleMotions is the number of ObstacleMotion values
+	numObstacleMotions
+)
+
+// String returns the name of the motion used in configurations
+func (m ObstacleMotion) String() string {
+	switch m {
+	case MotionDrift:
+		return "drift"
+	case MotionBounce:
+		return "bounce"
+	case MotionSine:
+		return "sine"
+	case MotionPatrol:
+		return "patrol"
+	case MotionChase:
+		return "chase"
+	}
+	return "unknown"
+}
+
+// ParseObstacleMotion returns the motion with the given name
+func ParseObstacleMotion(name string) (ObstacleMotion, error) {
+	for m := ObstacleMotion(0); m < numObstacleMotions; m++ {
+		if m.String() == name {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown obstacle motion %q", name)
+}
+
+const (
+	// swaying obstacles sway by minSway to maxSway to each side, taking
+	// 2*Pi/maxSwayRate to 2*Pi/minSwayRate ticks to sway back and forth
+	minSway     = 10
+	maxSway     = 40
+	minSwayRate = 0.02
+	maxSwayRate = 0.06
+	// patrolling obstacles visit minWaypoints to maxWaypoints waypoints
+	minWaypoints = 2
+	maxWaypoints = 4
+)
+
+// setMotion makes o move with the given motion, picking the motion's random
+// parameters with s.rng
+func (o *Obstacle) setMotion(s *Sim, motion ObstacleMotion) {
+	o.motion = motion
+	switch motion {
+	case MotionSine:
+		o.sway = minSway + s.rng.Float64()*(maxSway-minSway)
+		o.swayRate = minSwayRate + s.rng.Float64()*(maxSwayRate-minSwayRate)
+	case MotionPatrol:
+		// patrol the region of the spawner, or the whole area without one
+		area := Region{Width: float64(s.width), Height: float64(s.height)}
+		if r := s.spawners[o.spawner].Region; r != nil {
+			area = *r
+		}
+		o.waypoints = make([]waypoint, minWaypoints+s.rng.Intn(maxWaypoints-minWaypoints+1))
+		for i := range o.waypoints {
+			o.waypoints[i] = waypoint{
+				x: area.X + s.rng.Float64()*area.Width,
+				y: area.Y + s.rng.Float64()*area.Height,
+			}
+		}
+	}
+}
+
+// waypoint is a position in the simulation area for patrolling obstacles
+type waypoint struct {
+	x, y float64
+}
+
+// middle returns the middle of o, which moving obstacles steer by
+func (o *Obstacle) middle() (x, y float64) {
+	if o.kind == ObstacleCircle || o.kind == ObstaclePolygon {
+		return o.x, o.y
+	}
+	return o.center()
+}
+
+// move moves o by a single tick of its motion
+func (o *Obstacle) move(s *Sim) {
+	switch o.motion {
+	case MotionDrift:
+		o.x += o.dx
+		o.y += o.dy
+	case MotionBounce:
+		x, y := o.middle()
+		if (x <= 0 && o.dx < 0) || (x >= float64(s.width) && o.dx > 0) {
+			o.dx = -o.dx
+		}
+		if (y <= 0 && o.dy < 0) || (y >= float64(s.height) && o.dy > 0) {
+			o.dy = -o.dy
+		}
+		o.x += o.dx
+		o.y += o.dy
+	case MotionSine:
+		// sway at right angles to the drift by the change in the offset
+		speed := math.Hypot(o.dx, o.dy)
+		sway := o.sway * (math.Sin(o.swayPhase+o.swayRate) - math.Sin(o.swayPhase))
+		o.swayPhase += o.swayRate
+		o.x += o.dx - o.dy/speed*sway
+		o.y += o.dy + o.dx/speed*sway
+	case MotionPatrol:
+		p := &o.waypoints[o.waypoint]
+		if o.moveTowards(p.x, p.y) {
+			o.waypoint = (o.waypoint + 1) % len(o.waypoints)
+		}
+	case MotionChase:
+		x, y := o.middle()
+		var target *Creature
+		best := math.MaxFloat64
+		for _, c := range s.creatures {
+			if d := xyDist(x, y, c.x, c.y); d < best {
+				best = d
+				target = c
+			}
+		}
+		if target != nil {
+			o.moveTowards(target.x, target.y)
+		}
+	}
+}
+
+// moveTowards moves the middle of o towards (x, y) at the speed of its
+// velocity, returning true if it reached (x, y)
+func (o *Obstacle) moveTowards(x, y float64) bool {
+	mx, my := o.middle()
+	speed := math.Hypot(o.dx, o.dy)
+	d := xyDist(mx, my, x, y)
+	if d <= speed {
+		o.x += x - mx
+		o.y += y - my
+		return true
+	}
+	o.x += (x - mx) / d * speed
+	o.y += (y - my) / d * speed
+	return false
+}
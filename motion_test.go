@@ -0,0 +1,185 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMoveTowards(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		o            Obstacle
+		x, y         float64
+		wantX, wantY float64
+		reached      bool
+	}{
+		{
+			name: "step",
+			o:    Obstacle{kind: ObstacleCircle, x: 0, y: 0, dx: 3, dy: 4},
+			x:    30, y: 40,
+			wantX: 3, wantY: 4,
+		},
+		{
+			name: "step at speed",
+			o:    Obstacle{kind: ObstacleCircle, x: 10, y: 10, dx: -3, dy: 4},
+			x:    10, y: 0,
+			wantX: 10, wantY: 5,
+		},
+		{
+			name: "reach",
+			o:    Obstacle{kind: ObstaclePolygon, x: 10, y: 10, dx: 3, dy: 4},
+			x:    12, y: 11,
+			wantX: 12, wantY: 11,
+			reached: true,
+		},
+		{
+			name: "already there",
+			o:    Obstacle{kind: ObstacleCircle, x: 10, y: 10, dx: 1, dy: 1},
+			x:    10, y: 10,
+			wantX: 10, wantY: 10,
+			reached: true,
+		},
+		{
+			// planks steer by their middle, which starts at (10, 0)
+			name: "plank",
+			o:    Obstacle{kind: ObstaclePlank, x: 0, y: 0, length: 20, dx: 1, dy: 0},
+			x:    10, y: 10,
+			wantX: 0, wantY: 1,
+		},
+	} {
+		o := tc.o
+		if reached := o.moveTowards(tc.x, tc.y); reached != tc.reached {
+			t.Errorf("%s: moveTowards returned %v, want %v", tc.name, reached, tc.reached)
+		}
+		if math.Abs(o.x-tc.wantX) > 1e-9 || math.Abs(o.y-tc.wantY) > 1e-9 {
+			t.Errorf("%s: moved to (%v, %v), want (%v, %v)", tc.name, o.x, o.y, tc.wantX, tc.wantY)
+		}
+	}
+}
+
+func TestObstacleMotions(t *testing.T) {
+	creatures := []*Creature{{x: 20, y: 50}, {x: 60, y: 50}}
+	for _, tc := range []struct {
+		name      string
+		o         Obstacle
+		creatures []*Creature
+		ticks     int
+		// the middle of the obstacle after ticks
+		wantX, wantY float64
+	}{
+		{
+			name:  "drift",
+			o:     Obstacle{motion: MotionDrift, kind: ObstacleCircle, x: 50, y: 50, dx: 1, dy: 2},
+			ticks: 3,
+			wantX: 53, wantY: 56,
+		},
+		{
+			name:  "drift out of the area",
+			o:     Obstacle{motion: MotionDrift, kind: ObstacleCircle, x: 99, y: 50, dx: 1, dy: 0},
+			ticks: 3,
+			wantX: 102, wantY: 50,
+		},
+		{
+			name:  "bounce off the right edge",
+			o:     Obstacle{motion: MotionBounce, kind: ObstacleCircle, x: 99.5, y: 50, dx: 1, dy: 0},
+			ticks: 3,
+			wantX: 98.5, wantY: 50,
+		},
+		{
+			name:  "bounce off a corner",
+			o:     Obstacle{motion: MotionBounce, kind: ObstacleCircle, x: 0, y: 0, dx: -1, dy: -2},
+			ticks: 2,
+			wantX: 2, wantY: 4,
+		},
+		{
+			name:  "bounce a plank by its middle",
+			o:     Obstacle{motion: MotionBounce, kind: ObstaclePlank, x: 85, y: 50, length: 20, dx: 5, dy: 0},
+			ticks: 2,
+			wantX: 95, wantY: 50,
+		},
+		{
+			name:  "sine to one side",
+			o:     Obstacle{motion: MotionSine, kind: ObstacleCircle, x: 0, y: 50, dx: 1, dy: 0, sway: 10, swayRate: math.Pi / 2},
+			ticks: 1,
+			wantX: 1, wantY: 60,
+		},
+		{
+			name:  "sine to the other side",
+			o:     Obstacle{motion: MotionSine, kind: ObstacleCircle, x: 0, y: 50, dx: 1, dy: 0, sway: 10, swayRate: math.Pi / 2},
+			ticks: 3,
+			wantX: 3, wantY: 40,
+		},
+		{
+			name:  "sine back to the drift",
+			o:     Obstacle{motion: MotionSine, kind: ObstacleCircle, x: 50, y: 0, dx: 0, dy: 2, sway: 10, swayRate: math.Pi / 2},
+			ticks: 4,
+			wantX: 50, wantY: 8,
+		},
+		{
+			name: "patrol to the first waypoint",
+			o: Obstacle{motion: MotionPatrol, kind: ObstacleCircle, x: 0, y: 0, dx: 3, dy: 4,
+				waypoints: []waypoint{{10, 0}, {10, 10}}},
+			ticks: 2,
+			wantX: 10, wantY: 0,
+		},
+		{
+			name: "patrol to the next waypoint",
+			o: Obstacle{motion: MotionPatrol, kind: ObstacleCircle, x: 0, y: 0, dx: 3, dy: 4,
+				waypoints: []waypoint{{10, 0}, {10, 10}}},
+			ticks: 3,
+			wantX: 10, wantY: 5,
+		},
+		{
+			name: "patrol around the loop",
+			o: Obstacle{motion: MotionPatrol, kind: ObstacleCircle, x: 0, y: 0, dx: 3, dy: 4,
+				waypoints: []waypoint{{10, 0}, {10, 10}}},
+			ticks: 6,
+			wantX: 10, wantY: 0,
+		},
+		{
+			name:      "chase the nearest creature",
+			o:         Obstacle{motion: MotionChase, kind: ObstacleCircle, x: 50, y: 50, dx: 0, dy: 2},
+			creatures: creatures,
+			ticks:     3,
+			wantX:     56, wantY: 50,
+		},
+		{
+			name:      "chase onto a creature",
+			o:         Obstacle{motion: MotionChase, kind: ObstacleCircle, x: 50, y: 50, dx: 0, dy: 2},
+			creatures: creatures,
+			ticks:     10,
+			wantX:     60, wantY: 50,
+		},
+		{
+			name:  "chase without creatures",
+			o:     Obstacle{motion: MotionChase, kind: ObstacleCircle, x: 50, y: 50, dx: 0, dy: 2},
+			ticks: 3,
+			wantX: 50, wantY: 50,
+		},
+	} {
+		s := &Sim{width: 100, height: 100, creatures: tc.creatures}
+		o := tc.o
+		for i := 0; i < tc.ticks; i++ {
+			o.move(s)
+		}
+		if x, y := o.middle(); math.Abs(x-tc.wantX) > 1e-9 || math.Abs(y-tc.wantY) > 1e-9 {
+			t.Errorf("%s: moved to (%v, %v), want (%v, %v)", tc.name, x, y, tc.wantX, tc.wantY)
+		}
+	}
+}
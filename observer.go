@@ -0,0 +1,94 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// Observer receives callbacks for the events that happen inside a Sim.
+//
+// Callbacks are made synchronously from the goroutine running the Sim and
+// the Creature and TopCreature arguments are only valid for the duration
+// of the call, so observers should copy anything they want to keep.
+// Observers must not modify the Sim from a callback.
+type Observer interface {
+	// OnSpawn is called after the creature c is added to the simulation
+	OnSpawn(s *Sim, c *Creature)
+	// OnDeath is called after the creature c is removed from the simulation
+	OnDeath(s *Sim, c *Creature, d DeathRecord)
+	// OnEvolutionCycle is called at the start of each evolution cycle
+	// before the new creatures are spawned
+	OnEvolutionCycle(s *Sim, cycle int)
+	// OnHallOfFameUpdate is called when a different brain pattern becomes
+	// the best in the creature hall of fame
+	OnHallOfFameUpdate(s *Sim, best *TopCreature)
+	// OnTick is called at the end of each tick after the frame is drawn
+	OnTick(s *Sim)
+}
+
+// BaseObserver implements Observer with callbacks that do nothing, so that
+// observers can embed it and implement only the callbacks they need.
+type BaseObserver struct{}
+
+func (BaseObserver) OnSpawn(s *Sim, c *Creature)                  {}
+func (BaseObserver) OnDeath(s *Sim, c *Creature, d DeathRecord)   {}
+func (BaseObserver) OnEvolutionCycle(s *Sim, cycle int)           {}
+func (BaseObserver) OnHallOfFameUpdate(s *Sim, best *TopCreature) {}
+func (BaseObserver) OnTick(s *Sim)                                {}
+
+// AddObserver registers o to receive callbacks from the Sim
+func (s *Sim) AddObserver(o Observer) {
+	s.observers = append(s.observers, o)
+}
+
+// RemoveObserver unregisters o if it was registered with AddObserver
+func (s *Sim) RemoveObserver(o Observer) {
+	for i := range s.observers {
+		if s.observers[i] == o {
+			s.observers, s.observers[len(s.observers)-1] =
+				append(s.observers[:i], s.observers[i+1:]...), nil
+			return
+		}
+	}
+}
+
+func (s *Sim) notifySpawn(c *Creature) {
+	for _, o := range s.observers {
+		o.OnSpawn(s, c)
+	}
+}
+
+func (s *Sim) notifyDeath(c *Creature, d DeathRecord) {
+	for _, o := range s.observers {
+		o.OnDeath(s, c, d)
+	}
+}
+
+func (s *Sim) notifyEvolutionCycle(cycle int) {
+	for _, o := range s.observers {
+		o.OnEvolutionCycle(s, cycle)
+	}
+}
+
+func (s *Sim) notifyHallOfFameUpdate(best *TopCreature) {
+	for _, o := range s.observers {
+		o.OnHallOfFameUpdate(s, best)
+	}
+}
+
+func (s *Sim) notifyTick() {
+	for _, o := range s.observers {
+		o.OnTick(s)
+	}
+}
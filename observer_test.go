@@ -0,0 +1,150 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+)
+
+// observerEvent is a callback received by an eventRecorder
+type observerEvent struct {
+	recorder string
+	kind     string
+	creature *Creature
+	n        int
+}
+
+// eventRecorder is an Observer appending each callback it receives to events
+type eventRecorder struct {
+	name   string
+	events *[]observerEvent
+}
+
+func (r *eventRecorder) add(kind string, c *Creature, n int) {
+	*r.events = append(*r.events, observerEvent{r.name, kind, c, n})
+}
+
+func (r *eventRecorder) OnSpawn(s *Sim, c *Creature) { r.add("spawn", c, 0) }
+func (r *eventRecorder) OnDeath(s *Sim, c *Creature, d DeathRecord) {
+	r.add("death", c, d.Tick)
+}
+func (r *eventRecorder) OnEvolutionCycle(s *Sim, cycle int) { r.add("cycle", nil, cycle) }
+func (r *eventRecorder) OnHallOfFameUpdate(s *Sim, best *TopCreature) {
+	r.add("best", nil, int(best.score))
+}
+func (r *eventRecorder) OnTick(s *Sim) { r.add("tick", nil, s.tickCounter) }
+
+func TestObserverOrder(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.EvolutionCycleTicks = 20
+	s := NewSim(cfg, 1)
+	var events []observerEvent
+	a := &eventRecorder{"a", &events}
+	b := &eventRecorder{"b", &events}
+	s.AddObserver(a)
+	s.AddObserver(b)
+	ticks := 2*cfg.EvolutionCycleTicks + 1
+	for i := 0; i < ticks; i++ {
+		s.DoTick()
+	}
+
+	// each callback is made to a then b
+	if len(events)%2 != 0 {
+		t.Fatalf("%d callbacks were made, want pairs", len(events))
+	}
+	var got []observerEvent
+	for i := 0; i < len(events); i += 2 {
+		ea, eb := events[i], events[i+1]
+		if ea.recorder != "a" || eb.recorder != "b" {
+			t.Fatalf("callback %d was made to %s then %s", i, ea.recorder, eb.recorder)
+		}
+		eb.recorder = "a"
+		if ea != eb {
+			t.Fatalf("callback %d was %+v to a and %+v to b", i, ea, eb)
+		}
+		got = append(got, ea)
+	}
+
+	live := map[*Creature]bool{}
+	tick, cycles, deaths := 0, 0, 0
+	// the callbacks since the last tick
+	var inTick []string
+	for _, e := range got {
+		switch e.kind {
+		case "spawn":
+			if live[e.creature] {
+				t.Fatalf("tick %d: a live creature spawned", tick)
+			}
+			live[e.creature] = true
+		case "death":
+			if !live[e.creature] {
+				t.Fatalf("tick %d: a creature died that was not alive", tick)
+			}
+			if e.n != tick {
+				t.Fatalf("tick %d: a creature died on tick %d", tick, e.n)
+			}
+			delete(live, e.creature)
+			deaths++
+		case "cycle":
+			if tick%cfg.EvolutionCycleTicks != 0 || e.n != cycles {
+				t.Fatalf("tick %d: evolution cycle %d started", tick, e.n)
+			}
+			if len(inTick) != 0 {
+				t.Fatalf("tick %d: evolution cycle started after %v", tick, inTick)
+			}
+			cycles++
+		case "tick":
+			tick++
+			if e.n != tick {
+				t.Fatalf("tick %d: OnTick saw tick %d", tick, e.n)
+			}
+			inTick = inTick[:0]
+			continue
+		}
+		inTick = append(inTick, e.kind)
+	}
+	if tick != ticks || len(inTick) != 0 {
+		t.Errorf("%d ticks ended with OnTick, followed by %v, want %d", tick, inTick, ticks)
+	}
+	if deaths == 0 {
+		t.Errorf("no creatures died")
+	}
+	if cycles != 3 {
+		t.Errorf("%d evolution cycles started, want 3", cycles)
+	}
+	if len(live) != len(s.creatures) {
+		t.Errorf("%d creatures are alive by the callbacks, want %d", len(live), len(s.creatures))
+	}
+	for _, c := range s.creatures {
+		if !live[c] {
+			t.Errorf("a creature is alive without spawning")
+		}
+	}
+
+	// removed observers get no more callbacks
+	s.RemoveObserver(a)
+	events = events[:0]
+	s.DoTick()
+	for _, e := range events {
+		if e.recorder != "b" {
+			t.Fatalf("the removed observer got %+v", e)
+		}
+	}
+	if len(events) == 0 {
+		t.Errorf("the remaining observer got no callbacks")
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/llgcode/draw2d"
+	"github.com/llgcode/draw2d/draw2dkit"
+)
+
+// ObstacleKind is the shape and animation of an obstacle
+type ObstacleKind int
+
+const (
+	// ObstaclePlank is a straight line
+	ObstaclePlank ObstacleKind = iota
+	// ObstacleRotatingPlank is a straight line spinning about its middle
+	ObstacleRotatingPlank
+	// ObstacleCircle is a filled circle
+	ObstacleCircle
+	// ObstaclePolygon is a filled regular polygon
+	ObstaclePolygon
+	// ObstacleBar is a straight line that grows and shrinks about its middle
+	ObstacleBar
+	// numObstacleKinds is the number of ObstacleKind values
+	numObstacleKinds
+)
+
+// String returns the name of the kind used in configurations
+func (k ObstacleKind) String() string {
+	switch k {
+	case ObstaclePlank:
+		return "plank"
+	case ObstacleRotatingPlank:
+		return "rotating_plank"
+	case ObstacleCircle:
+		return "circle"
+	case ObstaclePolygon:
+		return "polygon"
+	case ObstacleBar:
+		return "bar"
+	}
+	return "unknown"
+}
+
+// ParseObstacleKind returns the kind with the given name
+func ParseObstacleKind(name string) (ObstacleKind, error) {
+	for k := ObstacleKind(0); k < numObstacleKinds; k++ {
+		if k.String() == name {
+			return k, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown obstacle kind %q", name)
+}
+
+const (
+	// the fastest rotating planks turn by maxObstacleSpin radians per tick,
+	// the slowest by a quarter of that
+	maxObstacleSpin = 0.04
+	// polygons have from minPolygonSides to maxPolygonSides sides
+	minPolygonSides = 3
+	maxPolygonSides = 6
+	// bars shrink to minBarLength of their longest length, and grow or
+	// shrink by up to maxBarGrowth per tick, at least a quarter of that
+	minBarLength = 0.25
+	maxBarGrowth = 1.5
+)
+
+// randomChoice returns one of names chosen with equal chances, or "" if
+// there are none. The rng is only used when there is a choice, so that runs
+// with only the original planks are the same as before there were others.
+func (s *Sim) randomChoice(names []string) string {
+	switch len(names) {
+	case 0:
+		return ""
+	case 1:
+		return names[0]
+	}
+	return names[s.rng.Intn(len(names))]
+}
+
+// randomObstacleKind returns one of the named obstacle kinds
+func (s *Sim) randomObstacleKind(kinds []string) ObstacleKind {
+	kind, _ := ParseObstacleKind(s.randomChoice(kinds))
+	return kind
+}
+
+// randomObstacleMotion returns one of the named obstacle motions
+func (s *Sim) randomObstacleMotion(motions []string) ObstacleMotion {
+	motion, _ := ParseObstacleMotion(s.randomChoice(motions))
+	return motion
+}
+
+// setKind makes o an obstacle of the given kind, sizing it from its
+// random length and picking the kind's random parameters with s.rng
+func (o *Obstacle) setKind(s *Sim, kind ObstacleKind) {
+	o.kind = kind
+	switch kind {
+	case ObstacleRotatingPlank:
+		spin := s.rng.Float64()*2 - 1
+		o.spin = spin*maxObstacleSpin*3/4 + math.Copysign(maxObstacleSpin/4, spin)
+	case ObstacleCircle:
+		// the length is the diameter, smaller than planks as circles
+		// block much more of the area
+		o.length /= 3
+	case ObstaclePolygon:
+		o.length /= 2
+		o.sides = minPolygonSides + s.rng.Intn(maxPolygonSides-minPolygonSides+1)
+	case ObstacleBar:
+		o.maxLength = o.length
+		o.length = o.maxLength * (minBarLength + s.rng.Float64()*(1-minBarLength))
+		growth := s.rng.Float64()*2 - 1
+		o.growth = growth*maxBarGrowth*3/4 + math.Copysign(maxBarGrowth/4, growth)
+	}
+}
+
+// center returns the middle of a plank or bar
+func (o *Obstacle) center() (x, y float64) {
+	return o.x + math.Cos(o.angle)*o.length/2, o.y + math.Sin(o.angle)*o.length/2
+}
+
+// setCenter moves a plank or bar so that its middle is at x, y
+func (o *Obstacle) setCenter(x, y float64) {
+	o.x = x - math.Cos(o.angle)*o.length/2
+	o.y = y - math.Sin(o.angle)*o.length/2
+}
+
+// step moves and animates o by a single tick
+func (o *Obstacle) step(s *Sim) {
+	o.move(s)
+	switch o.kind {
+	case ObstacleRotatingPlank:
+		x, y := o.center()
+		o.angle += o.spin
+		o.setCenter(x, y)
+	case ObstacleBar:
+		x, y := o.center()
+		o.length += o.growth
+		if min := o.maxLength * minBarLength; o.length < min {
+			o.length, o.growth = min, -o.growth
+		} else if o.length > o.maxLength {
+			o.length, o.growth = o.maxLength, -o.growth
+		}
+		o.setCenter(x, y)
+	}
+}
+
+// state returns the drawable state of o
+func (o *Obstacle) state() ObstacleState {
+	return ObstacleState{
+		ID:     o.id,
+		Kind:   o.kind,
+		X:      o.x,
+		Y:      o.y,
+		Angle:  o.angle,
+		Length: o.length,
+		Sides:  o.sides,
+	}
+}
+
+// vertex returns the position of corner i of a polygon, the corners are
+// in order of increasing angle around the center
+func (o *ObstacleState) vertex(i int) (x, y float64) {
+	a := o.Angle + 2*math.Pi*float64(i)/float64(o.Sides)
+	return o.X + math.Cos(a)*o.Length/2, o.Y + math.Sin(a)*o.Length/2
+}
+
+// Dist returns the distance from (x, y) in the simulation area to the
+// obstacle, which is zero inside circles and polygons
+func (o *ObstacleState) Dist(x, y float64) float64 {
+	switch o.Kind {
+	case ObstacleCircle:
+		return math.Max(0, xyDist(x, y, o.X, o.Y)-o.Length/2)
+	case ObstaclePolygon:
+		inside := true
+		best := math.MaxFloat64
+		for i := 0; i < o.Sides; i++ {
+			x1, y1 := o.vertex(i)
+			x2, y2 := o.vertex(i + 1)
+			best = math.Min(best, segmentDist(x, y, x1, y1, x2, y2))
+			// the polygon is convex, so points inside are on the same
+			// side of every edge
+			if (x2-x1)*(y-y1)-(y2-y1)*(x-x1) < 0 {
+				inside = false
+			}
+		}
+		if inside {
+			return 0
+		}
+		return best
+	}
+	return segmentDist(x, y, o.X, o.Y,
+		o.X+math.Cos(o.Angle)*o.Length, o.Y+math.Sin(o.Angle)*o.Length)
+}
+
+// drawObstacleShape draws a circle or polygon obstacle o at an offset of
+// border, filled with the current fill color
+func drawObstacleShape(gc draw2d.GraphicContext, o *ObstacleState, border float64) {
+	switch o.Kind {
+	case ObstacleCircle:
+		draw2dkit.Circle(gc, border+o.X, border+o.Y, o.Length/2)
+	case ObstaclePolygon:
+		for i := 0; i < o.Sides; i++ {
+			x, y := o.vertex(i)
+			if i == 0 {
+				gc.MoveTo(border+x, border+y)
+			} else {
+				gc.LineTo(border+x, border+y)
+			}
+		}
+		gc.Close()
+	}
+	gc.Fill()
+}
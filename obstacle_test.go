@@ -0,0 +1,86 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestObstacleDist(t *testing.T) {
+	plank := ObstacleState{Kind: ObstaclePlank, X: 10, Y: 10, Length: 10}
+	rotated := ObstacleState{Kind: ObstacleRotatingPlank, X: 10, Y: 10, Angle: math.Pi / 2, Length: 10}
+	bar := ObstacleState{Kind: ObstacleBar, X: 10, Y: 10, Angle: math.Pi, Length: 4}
+	circle := ObstacleState{Kind: ObstacleCircle, X: 10, Y: 10, Length: 4}
+	// a diamond with corners 1 from (10, 10) along each axis
+	diamond := ObstacleState{Kind: ObstaclePolygon, X: 10, Y: 10, Length: 2, Sides: 4}
+	// a triangle with corners at angles 0, 120 and 240 degrees
+	triangle := ObstacleState{Kind: ObstaclePolygon, X: 10, Y: 10, Length: 4, Sides: 3}
+	for _, tc := range []struct {
+		name string
+		o    *ObstacleState
+		x, y float64
+		want float64
+	}{
+		{"plank start", &plank, 10, 10, 0},
+		{"plank side", &plank, 15, 13, 3},
+		{"plank before start", &plank, 6, 13, 5},
+		{"plank past end", &plank, 25, 10, 5},
+		{"rotated plank side", &rotated, 12, 15, 2},
+		{"rotated plank past end", &rotated, 10, 23, 3},
+		{"bar middle", &bar, 8, 10, 0},
+		{"bar past end", &bar, 4, 10, 2},
+		{"bar side", &bar, 9, 7, 3},
+		{"circle center", &circle, 10, 10, 0},
+		{"circle inside", &circle, 11, 11, 0},
+		{"circle outside", &circle, 15, 10, 3},
+		{"circle diagonal", &circle, 13, 14, 3},
+		{"diamond center", &diamond, 10, 10, 0},
+		{"diamond inside", &diamond, 10.4, 9.4, 0},
+		{"diamond corner", &diamond, 13, 10, 2},
+		{"diamond edge", &diamond, 11, 11, math.Sqrt2 / 2},
+		{"triangle center", &triangle, 10, 10, 0},
+		{"triangle corner", &triangle, 14, 10, 2},
+		{"triangle edge", &triangle, 7, 10, 2},
+	} {
+		if got := tc.o.Dist(tc.x, tc.y); math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("%s: Dist(%v, %v) = %v, want %v", tc.name, tc.x, tc.y, got, tc.want)
+		}
+	}
+}
+
+func TestObstacleStep(t *testing.T) {
+	s := &Sim{width: 100, height: 100}
+	plank := Obstacle{kind: ObstacleRotatingPlank, x: 40, y: 50, length: 20, spin: 0.1}
+	bar := Obstacle{kind: ObstacleBar, x: 40, y: 50, length: 20, maxLength: 20, growth: 1.5}
+	for i := 0; i < 100; i++ {
+		plank.step(s)
+		if x, y := plank.center(); math.Abs(x-50) > 1e-9 || math.Abs(y-50) > 1e-9 {
+			t.Fatalf("tick %d: the rotating plank's center moved to (%v, %v)", i, x, y)
+		}
+		bar.step(s)
+		if x, y := bar.center(); math.Abs(x-50) > 1e-9 || math.Abs(y-50) > 1e-9 {
+			t.Fatalf("tick %d: the bar's center moved to (%v, %v)", i, x, y)
+		}
+		if bar.length < 20*minBarLength || bar.length > 20 {
+			t.Fatalf("tick %d: the bar's length is %v", i, bar.length)
+		}
+	}
+	if math.Abs(plank.angle-10) > 1e-9 {
+		t.Errorf("the rotating plank turned to %v, want 10", plank.angle)
+	}
+}
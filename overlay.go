@@ -0,0 +1,218 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/llgcode/draw2d"
+	"github.com/llgcode/draw2d/draw2dkit"
+)
+
+// overlay panel layout, in frame pixels
+const (
+	overlayBarWidth  = 8
+	overlayRowHeight = 24
+	overlayPadding   = 4
+)
+
+var (
+	overlayPanelColor = color.NRGBA{0xFF, 0xFF, 0xFF, 0xD0}
+	overlayRingColor  = color.RGBA{0xFF, 0x00, 0xFF, 0xFF}
+	overlayBarColor   = color.RGBA{0x40, 0x40, 0x40, 0xFF}
+)
+
+// Overlay draws debugging information on top of the world: the sensor rays
+// of every creature with their hit points, each creature's heading colored
+// by its brain outputs, and the brain state of a selected creature.
+type Overlay struct {
+	Enabled bool
+	// Selected is the ID of the creature to show the brain state of, if it
+	// is zero or the creature is gone the highest scoring creature is shown
+	Selected int
+}
+
+// Draw draws the sensor rays, headings and selection ring for the world
+// state w to gc in frame coordinates
+func (o *Overlay) Draw(gc draw2d.GraphicContext, w *WorldState) {
+	if len(w.Creatures) == 0 {
+		return
+	}
+	// restore the colors and line width after drawing, DrawWorld relies on
+	// the stroke color being unset
+	gc.Save()
+	defer gc.Restore()
+	maxDist := maxSensorDist(w)
+	for i := range w.Creatures {
+		drawSensorRays(gc, w, &w.Creatures[i], maxDist)
+	}
+	for i := range w.Creatures {
+		drawHeading(gc, w, &w.Creatures[i])
+	}
+	drawSelectionRing(gc, w, o.selected(w))
+}
+
+// DrawPanel draws the brain state panel for the world state w to gc in the
+// top left of area
+func (o *Overlay) DrawPanel(gc draw2d.GraphicContext, w *WorldState, area panelArea) {
+	if len(w.Creatures) == 0 {
+		return
+	}
+	gc.Save()
+	defer gc.Restore()
+	drawBrainPanel(gc, area, o.selected(w), maxSensorDist(w))
+}
+
+// selected returns the creature to show the brain state of
+func (o *Overlay) selected(w *WorldState) *CreatureState {
+	best := &w.Creatures[0]
+	for i := range w.Creatures {
+		c := &w.Creatures[i]
+		if c.ID == o.Selected {
+			return c
+		}
+		if c.Score > best.Score {
+			best = c
+		}
+	}
+	return best
+}
+
+// maxSensorDist returns the longest possible sensor distance in w
+func maxSensorDist(w *WorldState) float64 {
+	bounds := w.FrameBounds()
+	return math.Hypot(float64(bounds.Dx()), float64(bounds.Dy()))
+}
+
+// drawSelectionRing draws a ring around the selected creature c
+func drawSelectionRing(gc draw2d.GraphicContext, w *WorldState, c *CreatureState) {
+	borderWidthf := float64(w.BorderWidth)
+	gc.SetStrokeColor(overlayRingColor)
+	gc.SetLineWidth(2)
+	draw2dkit.Circle(gc, borderWidthf+c.X, borderWidthf+c.Y, w.CreatureRadius+4)
+	gc.Stroke()
+}
+
+// distanceColor maps a sensor distance to a color from red when near to
+// green when far
+func distanceColor(d, maxDist float64) color.RGBA {
+	t := math.Min(d/maxDist*4, 1)
+	return color.RGBA{uint8(0xFF * (1 - t)), uint8(0xC0 * t), 0x00, 0xFF}
+}
+
+// outputColor maps the turn and move brain outputs to a color, red for
+// turning clockwise, blue for counter clockwise and green for moving forward
+func outputColor(turn, move float64) color.RGBA {
+	return color.RGBA{
+		uint8(0xFF * math.Max(turn, 0)),
+		uint8(0xFF * (move + 1) / 2),
+		uint8(0xFF * math.Max(-turn, 0)),
+		0xFF,
+	}
+}
+
+// drawSensorRays draws the rays the creature c sensed along in its last
+// tick, each ending in a dot at the hit point colored by the distance
+func drawSensorRays(gc draw2d.GraphicContext, w *WorldState, c *CreatureState, maxDist float64) {
+	borderWidthf := float64(w.BorderWidth)
+	cx := borderWidthf + c.SensorX
+	cy := borderWidthf + c.SensorY
+	gc.SetLineWidth(0.5)
+	for i, d := range c.Sensors {
+		if d == math.MaxFloat64 {
+			continue
+		}
+		// the same angles as Creature.GetAction
+		angle := math.Pi*2*float64(i)/numBrainInputs + c.SensorAngle
+		x := cx + math.Cos(angle)*d
+		y := cy + math.Sin(angle)*d
+		col := distanceColor(d, maxDist)
+		gc.SetStrokeColor(col)
+		gc.MoveTo(cx, cy)
+		gc.LineTo(x, y)
+		gc.Stroke()
+		gc.SetFillColor(col)
+		draw2dkit.Circle(gc, x, y, 1.5)
+		gc.Fill()
+	}
+}
+
+// drawHeading redraws the creature's heading dot colored by its outputs
+func drawHeading(gc draw2d.GraphicContext, w *WorldState, c *CreatureState) {
+	borderWidthf := float64(w.BorderWidth)
+	ax := math.Cos(c.Angle)
+	ay := math.Sin(c.Angle)
+	gc.SetFillColor(outputColor(c.Turn, c.Move))
+	draw2dkit.Circle(gc, borderWidthf+c.X+ax*3, borderWidthf+c.Y+ay*3, 2)
+	gc.Fill()
+}
+
+// drawBrainPanel draws the brain state of c as rows of bars in the top left
+// of area, see drawSensorBars, drawOutputBars and drawMemoryBars
+func drawBrainPanel(gc draw2d.GraphicContext, area panelArea, c *CreatureState, maxDist float64) {
+	left := area.left + overlayPadding
+	top := area.top + overlayPadding
+	width := float64(memorySize)*overlayBarWidth + 2*overlayPadding
+	height := float64(3*overlayRowHeight + 4*overlayPadding)
+	gc.SetFillColor(overlayPanelColor)
+	draw2dkit.Rectangle(gc, left, top, left+width, top+height)
+	gc.Fill()
+	x := left + overlayPadding
+	y := top + overlayPadding
+	drawSensorBars(gc, x, y, c, maxDist)
+	y += overlayRowHeight + overlayPadding
+	drawOutputBars(gc, x, y, c)
+	y += overlayRowHeight + overlayPadding
+	drawMemoryBars(gc, x, y, c)
+}
+
+// drawSensorBars draws a row of bars at x, y for the sensor distances of c,
+// nearer is taller
+func drawSensorBars(gc draw2d.GraphicContext, x, y float64, c *CreatureState, maxDist float64) {
+	for i, d := range c.Sensors {
+		h := (1 - math.Min(d/maxDist, 1)) * overlayRowHeight
+		gc.SetFillColor(distanceColor(d, maxDist))
+		bx := x + float64(i)*overlayBarWidth
+		draw2dkit.Rectangle(gc, bx, y+overlayRowHeight-h, bx+overlayBarWidth-1, y+overlayRowHeight)
+		gc.Fill()
+	}
+}
+
+// drawOutputBars draws bars at x, y for the turn and move outputs of c
+func drawOutputBars(gc draw2d.GraphicContext, x, y float64, c *CreatureState) {
+	gc.SetFillColor(outputColor(c.Turn, 0))
+	drawSignedBar(gc, x, y, c.Turn)
+	gc.SetFillColor(outputColor(0, c.Move))
+	drawSignedBar(gc, x+overlayBarWidth, y, c.Move)
+}
+
+// drawMemoryBars draws a row of bars at x, y for the memory values of c
+func drawMemoryBars(gc draw2d.GraphicContext, x, y float64, c *CreatureState) {
+	gc.SetFillColor(overlayBarColor)
+	for i, m := range c.Memory {
+		drawSignedBar(gc, x+float64(i)*overlayBarWidth, y, m)
+	}
+}
+
+// drawSignedBar fills a bar for v in [-1, 1] in the panel row at x, y,
+// growing up when positive and down when negative
+func drawSignedBar(gc draw2d.GraphicContext, x, y, v float64) {
+	mid := y + overlayRowHeight/2
+	draw2dkit.Rectangle(gc, x, mid, x+overlayBarWidth-1, mid-v*overlayRowHeight/2)
+	gc.Fill()
+}
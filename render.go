@@ -0,0 +1,191 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/llgcode/draw2d"
+	"github.com/llgcode/draw2d/draw2dimg"
+)
+
+// CreatureState is the drawable state of a Creature
+type CreatureState struct {
+	ID    int
+	X     float64
+	Y     float64
+	Angle float64
+	Color color.Color
+	Score int64
+	// The tick the creature was spawned at, and its genome
+	Born    int
+	Lineage Lineage
+	// The brain inputs, the distances along the sensor rays, and outputs
+	// from the last tick, the rays were cast from SensorX, SensorY at
+	// SensorAngle before the creature moved
+	Sensors     []float64
+	SensorX     float64
+	SensorY     float64
+	SensorAngle float64
+	Turn        float64
+	Move        float64
+	Memory      []float64
+	Weights     []float64 // see Brain.GetWeights
+}
+
+// ObstacleState is the drawable state of an Obstacle
+type ObstacleState struct {
+	ID     int
+	Kind   ObstacleKind
+	X      float64
+	Y      float64
+	Angle  float64
+	Length float64
+	Sides  int
+}
+
+// WorldState is a read-only view of the simulation after a tick.
+// Positions are within the simulation area, which is surrounded by a border
+// of BorderWidth in the frame.
+type WorldState struct {
+	Tick        int
+	Width       int
+	Height      int
+	BorderWidth int
+	Creatures   []CreatureState
+	Obstacles   []ObstacleState
+	Walls       []WallState
+	// The best score in the hall of fame
+	BestScore int64
+	// The number of evolution cycles so far
+	Cycle int
+	// The difficulty level and the highest level of the curriculum, which
+	// are zero without one
+	Level    int
+	MaxLevel int
+	// The sizes to draw creatures and obstacles at
+	CreatureRadius float64
+	ObstacleWidth  float64
+}
+
+// FrameBounds returns the bounds of a frame holding the simulation area
+// and the border
+func (w *WorldState) FrameBounds() image.Rectangle {
+	return image.Rect(0, 0, w.Width+w.BorderWidth*2, w.Height+w.BorderWidth*2)
+}
+
+// Renderer draws the simulation after each tick
+type Renderer interface {
+	// Render draws w after each tick and Input, w is only valid until
+	// Render returns
+	Render(w *WorldState)
+}
+
+// NopRenderer is a Renderer that draws nothing, for headless runs
+type NopRenderer struct{}
+
+func (NopRenderer) Render(w *WorldState) {}
+
+// LinePreview is a line drawn over the world in frame coordinates, such as
+// a wall that is being drawn
+type LinePreview struct {
+	Enabled        bool
+	X1, Y1, X2, Y2 float64
+}
+
+// Draw draws the preview to gc if it is enabled
+func (p *LinePreview) Draw(gc draw2d.GraphicContext) {
+	if !p.Enabled {
+		return
+	}
+	gc.Save()
+	defer gc.Restore()
+	gc.SetStrokeColor(color.NRGBA{WallColor.R, WallColor.G, WallColor.B, 0x80})
+	gc.SetLineWidth(wallWidth)
+	gc.MoveTo(p.X1, p.Y1)
+	gc.LineTo(p.X2, p.Y2)
+	gc.Stroke()
+}
+
+// RasterRenderer is a Renderer that draws each frame into an image with
+// draw2d, Frame holds the last frame drawn. The world is drawn through the
+// Camera and the panels on top of it are drawn in view coordinates.
+type RasterRenderer struct {
+	Frame *image.RGBA
+	// ViewSize is the size of Frame, if it is zero Frame is the size of
+	// the simulation frame
+	ViewSize  image.Point
+	Camera    Camera
+	Overlay   Overlay   // drawn on top of the world if enabled
+	HUD       HUD       // drawn on top of the world if enabled
+	Charts    Charts    // drawn on top of the world if enabled
+	Inspector Inspector // drawn on top if a creature is selected
+	Preview   LinePreview
+	gc        *draw2dimg.GraphicContext
+	area      panelArea // the panel area of the last frame
+}
+
+// NewRasterRenderer returns a RasterRenderer with an empty frame of size
+// bounds, the frame is reallocated if the simulation or view size changes.
+func NewRasterRenderer(bounds image.Rectangle) *RasterRenderer {
+	r := &RasterRenderer{}
+	r.resize(bounds)
+	return r
+}
+
+func (r *RasterRenderer) resize(bounds image.Rectangle) {
+	r.Frame = image.NewRGBA(bounds)
+	r.gc = draw2dimg.NewGraphicContext(r.Frame)
+}
+
+// Render implements Renderer by drawing w into r.Frame
+func (r *RasterRenderer) Render(w *WorldState) {
+	bounds := w.FrameBounds()
+	if r.ViewSize != (image.Point{}) {
+		bounds = image.Rectangle{Max: r.ViewSize}
+	}
+	if bounds != r.Frame.Bounds() {
+		r.resize(bounds)
+	}
+	r.Camera.update(w, bounds)
+	draw.Draw(r.Frame, bounds, image.NewUniform(Black), image.ZP, draw.Src)
+	r.gc.Save()
+	r.gc.Translate(r.Camera.dx, r.Camera.dy)
+	r.gc.Scale(r.Camera.scale, r.Camera.scale)
+	DrawWorld(r.gc, w)
+	if r.Overlay.Enabled {
+		r.Overlay.Draw(r.gc, w)
+	}
+	r.Inspector.DrawSelection(r.gc, w)
+	r.Preview.Draw(r.gc)
+	r.gc.Restore()
+	r.area = r.Camera.area(w)
+	if r.Overlay.Enabled {
+		r.Overlay.DrawPanel(r.gc, w, r.area)
+	}
+	r.HUD.Draw(r.gc, w, r.area)
+	r.Charts.Draw(r.gc, w, r.Inspector.beside(w, r.area, chartWidth))
+	r.Inspector.Draw(r.gc, w, r.area)
+}
+
+// Area returns the area of the view the panels were laid out in for the
+// last frame, see Inspector.ButtonContains
+func (r *RasterRenderer) Area() panelArea {
+	return r.area
+}
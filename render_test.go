@@ -0,0 +1,93 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// stateRecorder is a Renderer recording the tick and number of creatures
+// of each state it is given
+type stateRecorder struct {
+	ticks     []int
+	creatures []int
+}
+
+func (r *stateRecorder) Render(w *WorldState) {
+	r.ticks = append(r.ticks, w.Tick)
+	r.creatures = append(r.creatures, len(w.Creatures))
+}
+
+func TestSimRenders(t *testing.T) {
+	s := NewSim(DefaultConfig(), 1)
+	r := &stateRecorder{}
+	s.SetRenderer(r)
+	var want stateRecorder
+	for i := 1; i <= 3; i++ {
+		s.DoTick()
+		want.ticks = append(want.ticks, i)
+		want.creatures = append(want.creatures, len(s.creatures))
+	}
+	// inputs are rendered before the next tick
+	s.ApplyInput(Input{Kind: InputSpawnRandom})
+	want.ticks = append(want.ticks, 3)
+	want.creatures = append(want.creatures, len(s.creatures))
+	if !reflect.DeepEqual(*r, want) {
+		t.Errorf("rendered %+v, want %+v", *r, want)
+	}
+}
+
+func TestRenderingDoesNotChangeSim(t *testing.T) {
+	cfg := DefaultConfig()
+	drawn := NewSim(cfg, 1)
+	r := NewRasterRenderer(drawn.FrameBounds())
+	r.HUD.Enabled = true
+	r.Overlay.Enabled = true
+	drawn.SetRenderer(r)
+	headless := NewSim(cfg, 1)
+	for i := 0; i < 20; i++ {
+		drawn.DoTick()
+		headless.DoTick()
+	}
+	a, b := drawn.State(), headless.State()
+	if len(a.Creatures) != len(b.Creatures) {
+		t.Fatalf("%d creatures were drawn, %d headless", len(a.Creatures), len(b.Creatures))
+	}
+	for i := range a.Creatures {
+		ca, cb := &a.Creatures[i], &b.Creatures[i]
+		if ca.ID != cb.ID || ca.X != cb.X || ca.Y != cb.Y || ca.Score != cb.Score {
+			t.Errorf("drawn creature %+v differs from headless %+v", *ca, *cb)
+		}
+	}
+	if r.Frame.Bounds() != drawn.FrameBounds() {
+		t.Errorf("rendered a %v frame, want %v", r.Frame.Bounds(), drawn.FrameBounds())
+	}
+	// most of the arena is background
+	background := 0
+	bounds := r.Frame.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if r.Frame.At(x, y) == BGColor {
+				background++
+			}
+		}
+	}
+	if background < cfg.Width*cfg.Height/2 {
+		t.Errorf("%d pixels of the rendered frame are background", background)
+	}
+}
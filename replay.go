@@ -0,0 +1,358 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+)
+
+// replayVersion is the version of the replay log format, it must be
+// incremented whenever the format or the simulation changes such that
+// older replays can no longer be played back.
+const replayVersion = 6
+
+// replayChecksumTicks is the number of ticks between logged checksums
+const replayChecksumTicks = 30
+
+// ReplayHeader is the first entry in a replay log and holds everything
+// needed to recreate the Sim the run started with.
+type ReplayHeader struct {
+	Version int
+	Seed    int64
+	Config  Config
+}
+
+// ReplayEventKind is the kind of a ReplayEvent
+type ReplayEventKind int
+
+const (
+	// ReplaySpawn records the genome of a spawned creature
+	ReplaySpawn ReplayEventKind = iota
+	// ReplayInput records an external Input applied to the Sim
+	ReplayInput
+	// ReplayChecksum records the Sim.Checksum after a tick
+	ReplayChecksum
+	// ReplayEnd is the last event in a log, recording the final checksum
+	ReplayEnd
+)
+
+// ReplayEvent is a single entry in a replay log after the ReplayHeader.
+// Events are logged in the order they happened.
+type ReplayEvent struct {
+	Kind ReplayEventKind
+	// The value of the Sim's tick counter when the event happened
+	Tick     int
+	Genome   []float64 // for ReplaySpawn
+	Input    Input     // for ReplayInput
+	Checksum uint64    // for ReplayChecksum and ReplayEnd
+}
+
+// Checksum returns a hash of the current state of the creatures,
+// obstacles and walls in the simulation for detecting divergent replays.
+func (s *Sim) Checksum() uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	write := func(v uint64) {
+		binary.LittleEndian.PutUint64(buf[:], v)
+		h.Write(buf[:])
+	}
+	write(uint64(s.tickCounter))
+	for _, c := range s.creatures {
+		write(math.Float64bits(c.x))
+		write(math.Float64bits(c.y))
+		write(math.Float64bits(c.angle))
+		write(uint64(c.score))
+	}
+	for i := range s.obstacles {
+		write(math.Float64bits(s.obstacles[i].x))
+		write(math.Float64bits(s.obstacles[i].y))
+		write(math.Float64bits(s.obstacles[i].angle))
+	}
+	for i := range s.walls {
+		write(math.Float64bits(s.walls[i].x1))
+		write(math.Float64bits(s.walls[i].y1))
+		write(math.Float64bits(s.walls[i].x2))
+		write(math.Float64bits(s.walls[i].y2))
+	}
+	return h.Sum64()
+}
+
+// ReplayRecorder is an Observer and InputObserver that records a run to a
+// compressed replay log. The Sim must be freshly created when the recorder
+// is registered so that the run can be recreated from the seed.
+type ReplayRecorder struct {
+	BaseObserver
+	zw       *gzip.Writer
+	enc      *gob.Encoder
+	interval int
+	err      error
+}
+
+// NewReplayRecorder writes the header for s to w and returns a recorder
+// that will log a checksum every interval ticks. The recorder must still be
+// registered with s.AddObserver and closed with Close when the run is done.
+func NewReplayRecorder(w io.Writer, s *Sim, interval int) (*ReplayRecorder, error) {
+	zw := gzip.NewWriter(w)
+	r := &ReplayRecorder{
+		zw:       zw,
+		enc:      gob.NewEncoder(zw),
+		interval: interval,
+	}
+	err := r.enc.Encode(&ReplayHeader{
+		Version: replayVersion,
+		Seed:    s.seed,
+		Config:  s.cfg,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// write logs e unless a previous write failed
+func (r *ReplayRecorder) write(e *ReplayEvent) {
+	if r.err != nil {
+		return
+	}
+	r.err = r.enc.Encode(e)
+}
+
+// OnSpawn implements Observer by logging the creature's genome
+func (r *ReplayRecorder) OnSpawn(s *Sim, c *Creature) {
+	weights := c.brain.GetWeights()
+	genome := make([]float64, len(weights))
+	copy(genome, weights)
+	r.write(&ReplayEvent{
+		Kind:   ReplaySpawn,
+		Tick:   s.tickCounter,
+		Genome: genome,
+	})
+}
+
+// OnInput implements InputObserver by logging in
+func (r *ReplayRecorder) OnInput(s *Sim, in Input) {
+	r.write(&ReplayEvent{
+		Kind:  ReplayInput,
+		Tick:  s.tickCounter,
+		Input: in,
+	})
+}
+
+// OnTick implements Observer by logging a checksum every interval ticks
+func (r *ReplayRecorder) OnTick(s *Sim) {
+	if r.interval > 0 && s.tickCounter%r.interval == 0 {
+		r.write(&ReplayEvent{
+			Kind:     ReplayChecksum,
+			Tick:     s.tickCounter,
+			Checksum: s.Checksum(),
+		})
+	}
+}
+
+// Close logs the end of the run for s and flushes the log.
+// Close does not close the underlying io.Writer.
+func (r *ReplayRecorder) Close(s *Sim) error {
+	r.write(&ReplayEvent{
+		Kind:     ReplayEnd,
+		Tick:     s.tickCounter,
+		Checksum: s.Checksum(),
+	})
+	if err := r.zw.Close(); r.err == nil {
+		r.err = err
+	}
+	return r.err
+}
+
+// ReplayPlayer recreates a recorded run tick-for-tick. If verifying it also
+// checks the spawned genomes and checksums against the log and fails as soon
+// as the replayed run diverges.
+//
+// Replays are only reproducible on the same platform and build as the
+// recording, as floating point results may differ between architectures.
+type ReplayPlayer struct {
+	BaseObserver
+	sim    *Sim
+	dec    *gob.Decoder
+	verify bool
+	next   *ReplayEvent // the next unprocessed event, if read
+	done   bool
+	err    error
+}
+
+// NewReplayPlayer reads the header from the replay log r and returns a
+// player for it, the recreated simulation is available from Sim
+func NewReplayPlayer(r io.Reader, verify bool) (*ReplayPlayer, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	dec := gob.NewDecoder(zr)
+	var h ReplayHeader
+	if err := dec.Decode(&h); err != nil {
+		return nil, err
+	}
+	if h.Version != replayVersion {
+		return nil, fmt.Errorf("replay: unsupported version %d, expected %d",
+			h.Version, replayVersion)
+	}
+	if err := h.Config.Validate(); err != nil {
+		return nil, fmt.Errorf("replay: %v", err)
+	}
+	p := &ReplayPlayer{
+		sim:    NewSim(h.Config, h.Seed),
+		dec:    dec,
+		verify: verify,
+	}
+	p.sim.AddObserver(p)
+	return p, nil
+}
+
+// Sim returns the simulation being replayed
+func (p *ReplayPlayer) Sim() *Sim {
+	return p.sim
+}
+
+// peek returns the next event without consuming it
+func (p *ReplayPlayer) peek() (*ReplayEvent, error) {
+	if p.next == nil {
+		e := &ReplayEvent{}
+		if err := p.dec.Decode(e); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, fmt.Errorf("replay: failed to read event: %v", err)
+		}
+		p.next = e
+	}
+	return p.next, nil
+}
+
+// pop consumes and returns the next event
+func (p *ReplayPlayer) pop() (*ReplayEvent, error) {
+	e, err := p.peek()
+	p.next = nil
+	return e, err
+}
+
+// fail records the first error encountered while replaying
+func (p *ReplayPlayer) fail(err error) {
+	if p.err == nil {
+		p.err = err
+	}
+}
+
+// diverged records a divergence at the current tick
+func (p *ReplayPlayer) diverged(format string, args ...interface{}) {
+	p.fail(fmt.Errorf("replay: diverged at tick %d: %s",
+		p.sim.tickCounter, fmt.Sprintf(format, args...)))
+}
+
+// OnSpawn implements Observer by consuming the logged spawn and
+// checking the genome if verifying
+func (p *ReplayPlayer) OnSpawn(s *Sim, c *Creature) {
+	if p.err != nil {
+		return
+	}
+	e, err := p.pop()
+	if err != nil {
+		p.fail(err)
+		return
+	}
+	if !p.verify {
+		return
+	}
+	if e.Kind != ReplaySpawn || e.Tick != s.tickCounter {
+		p.diverged("unexpected spawn, expected event kind %d at tick %d",
+			e.Kind, e.Tick)
+		return
+	}
+	weights := c.brain.GetWeights()
+	if len(weights) != len(e.Genome) {
+		p.diverged("spawned genome has the wrong length")
+		return
+	}
+	for i := range weights {
+		if weights[i] != e.Genome[i] {
+			p.diverged("spawned genome differs at weight %d", i)
+			return
+		}
+	}
+}
+
+// OnTick implements Observer by consuming a logged checksum for this tick
+// and comparing it if verifying
+func (p *ReplayPlayer) OnTick(s *Sim) {
+	if p.err != nil {
+		return
+	}
+	e, err := p.peek()
+	if err != nil {
+		p.fail(err)
+		return
+	}
+	if e.Kind != ReplayChecksum || e.Tick != s.tickCounter {
+		return
+	}
+	p.next = nil
+	if p.verify && e.Checksum != s.Checksum() {
+		p.diverged("checksum mismatch")
+	}
+}
+
+// Step applies the inputs logged for the current tick and then runs the
+// simulation by a single tick. Step returns true once the end of the log
+// has been reached, or an error if the log is invalid or if verifying and
+// the replay has diverged.
+func (p *ReplayPlayer) Step() (done bool, err error) {
+	for !p.done && p.err == nil {
+		e, err := p.peek()
+		if err != nil {
+			p.fail(err)
+			break
+		}
+		if e.Tick < p.sim.tickCounter {
+			p.diverged("missed event kind %d from tick %d", e.Kind, e.Tick)
+			break
+		}
+		if e.Tick > p.sim.tickCounter {
+			p.sim.DoTick()
+			break
+		}
+		switch e.Kind {
+		case ReplayInput:
+			p.next = nil
+			p.sim.ApplyInput(e.Input)
+		case ReplayEnd:
+			p.next = nil
+			p.done = true
+			if p.verify && e.Checksum != p.sim.Checksum() {
+				p.diverged("final checksum mismatch")
+			}
+		default:
+			// spawns and checksums are consumed during DoTick
+			p.sim.DoTick()
+			return p.done, p.err
+		}
+	}
+	return p.done, p.err
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/gob"
+	"strings"
+	"testing"
+)
+
+// recordRun records ticks ticks of a simulation of cfg with seed, applying
+// a few inputs of each kind along the way, and returns the replay log and
+// the final checksum
+func recordRun(t *testing.T, cfg Config, seed int64, ticks int) ([]byte, uint64) {
+	var buf bytes.Buffer
+	s := NewSim(cfg, seed)
+	rec, err := NewReplayRecorder(&buf, s, replayChecksumTicks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.AddObserver(rec)
+	for s.tickCounter < ticks {
+		switch s.tickCounter {
+		case 5:
+			s.ApplyInput(Input{Kind: InputSpawnRandom})
+		case 20:
+			s.ApplyInput(Input{Kind: InputAddWall, X: 50, Y: 300, X2: 150, Y2: 320})
+		case 40:
+			if len(s.creatures) > 1 {
+				s.ApplyInput(Input{Kind: InputKill, Target: s.creatures[0].id})
+				s.ApplyInput(Input{Kind: InputClone, Target: s.creatures[0].id})
+			}
+		case 60:
+			if len(s.obstacles) > 0 {
+				s.ApplyInput(Input{Kind: InputMoveObstacle,
+					Target: s.obstacles[0].id, X: 200, Y: 100})
+			}
+		}
+		s.DoTick()
+	}
+	if err := rec.Close(s); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes(), s.Checksum()
+}
+
+// playRun plays the replay log b back and returns the replayed simulation
+func playRun(b []byte, verify bool, setup func(*Sim)) (*Sim, error) {
+	p, err := NewReplayPlayer(bytes.NewReader(b), verify)
+	if err != nil {
+		return nil, err
+	}
+	if setup != nil {
+		setup(p.Sim())
+	}
+	for {
+		done, err := p.Step()
+		if err != nil || done {
+			return p.Sim(), err
+		}
+	}
+}
+
+func TestReplayVerify(t *testing.T) {
+	gauntlet, err := LoadScenario("gauntlet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// end an episode during the run
+	gauntlet.EpisodeTicks = 50
+	for _, cfg := range []Config{DefaultConfig(), gauntlet} {
+		const ticks = 120
+		log, checksum := recordRun(t, cfg, 42, ticks)
+		s, err := playRun(log, true, nil)
+		if err != nil {
+			t.Fatalf("replay failed: %v", err)
+		}
+		if s.tickCounter != ticks {
+			t.Errorf("replay ended at tick %d, want %d", s.tickCounter, ticks)
+		}
+		if s.Checksum() != checksum {
+			t.Errorf("replay checksum %x, want %x", s.Checksum(), checksum)
+		}
+	}
+}
+
+func TestReplayVerifyDiverged(t *testing.T) {
+	log, _ := recordRun(t, DefaultConfig(), 42, 60)
+	// an input that was not recorded changes the run
+	_, err := playRun(log, true, func(s *Sim) {
+		s.AddWall(0, 0, 400, 700)
+	})
+	if err == nil || !strings.Contains(err.Error(), "diverged") {
+		t.Errorf("replay with an extra wall returned %v, want a divergence", err)
+	}
+}
+
+func TestReplayVersion(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	err := gob.NewEncoder(zw).Encode(&ReplayHeader{
+		Version: replayVersion - 1,
+		Seed:    1,
+		Config:  DefaultConfig(),
+	})
+	if err == nil {
+		err = zw.Close()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewReplayPlayer(&buf, true)
+	if err == nil || !strings.Contains(err.Error(), "unsupported version") {
+		t.Errorf("replay of an old version returned %v, want unsupported version", err)
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image"
+	"image/draw"
+	"sync"
+	"time"
+)
+
+// TripleBuffer hands frames from a producer to a consumer running at
+// different rates without either waiting for the other. The producer draws
+// to the back buffer and publishes it, the consumer takes the most recently
+// published frame, and frames the consumer misses are dropped.
+type TripleBuffer struct {
+	mu    sync.Mutex
+	front *image.RGBA // read by the consumer
+	ready *image.RGBA // the last published frame
+	back  *image.RGBA // drawn by the producer
+	fresh bool        // true if ready is newer than front
+}
+
+// NewTripleBuffer returns a TripleBuffer of empty frames with bounds
+func NewTripleBuffer(bounds image.Rectangle) *TripleBuffer {
+	return &TripleBuffer{
+		front: image.NewRGBA(bounds),
+		ready: image.NewRGBA(bounds),
+		back:  image.NewRGBA(bounds),
+	}
+}
+
+// Publish copies img to the back buffer and makes it the latest frame,
+// it must only be called by the producer. Frames may change size.
+func (b *TripleBuffer) Publish(img *image.RGBA) {
+	if b.back.Bounds() != img.Bounds() {
+		b.back = image.NewRGBA(img.Bounds())
+	}
+	draw.Draw(b.back, b.back.Bounds(), img, img.Bounds().Min, draw.Src)
+	b.mu.Lock()
+	b.back, b.ready = b.ready, b.back
+	b.fresh = true
+	b.mu.Unlock()
+}
+
+// Front returns the latest published frame, which the consumer may read
+// until the next call to Front
+func (b *TripleBuffer) Front() *image.RGBA {
+	b.mu.Lock()
+	if b.fresh {
+		b.front, b.ready = b.ready, b.front
+		b.fresh = false
+	}
+	b.mu.Unlock()
+	return b.front
+}
+
+// SimRunner runs the simulation on its own goroutine, so that slow ticks do
+// not stall the render thread and vice versa. Each frame interval it calls
+// AdvanceSim and publishes the frame drawn by renderer to Frames.
+//
+// While running, the simulation and all state used by AdvanceSim, such as
+// the renderer, must only be used from functions passed to Do.
+type SimRunner struct {
+	Frames   *TripleBuffer
+	commands chan func()
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewSimRunner returns a stopped SimRunner publishing frames of bounds
+func NewSimRunner(bounds image.Rectangle) *SimRunner {
+	return &SimRunner{
+		Frames:   NewTripleBuffer(bounds),
+		commands: make(chan func(), 64),
+	}
+}
+
+// Start starts the simulation goroutine if it is not running
+func (r *SimRunner) Start() {
+	if r.stop != nil {
+		return
+	}
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	go r.run(r.stop, r.done)
+}
+
+// Stop stops the simulation goroutine and waits for it to exit, commands
+// that have not run yet are run before Stop returns
+func (r *SimRunner) Stop() {
+	if r.stop == nil {
+		return
+	}
+	close(r.stop)
+	<-r.done
+	r.stop, r.done = nil, nil
+	for {
+		select {
+		case f := <-r.commands:
+			f()
+		default:
+			return
+		}
+	}
+}
+
+// Do runs f on the simulation goroutine, or right away if it is stopped.
+// Start, Stop and Do must be called from the same goroutine.
+func (r *SimRunner) Do(f func()) {
+	if r.stop == nil {
+		f()
+		return
+	}
+	r.commands <- f
+}
+
+func (r *SimRunner) run(stop, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(frameInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case f := <-r.commands:
+			f()
+		case <-ticker.C:
+			AdvanceSim()
+			r.Frames.Publish(renderer.Frame)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image"
+	"image/color"
+	"sync"
+	"testing"
+)
+
+// frameNumber returns the number of the frame img was filled with by
+// fillFrame, and whether every pixel has it
+func frameNumber(img *image.RGBA) (n int, whole bool) {
+	first := img.RGBAAt(0, 0)
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if img.RGBAAt(x, y) != first {
+				return 0, false
+			}
+		}
+	}
+	return int(first.R)<<16 | int(first.G)<<8 | int(first.B), true
+}
+
+// fillFrame fills img with the frame number n
+func fillFrame(img *image.RGBA, n int) {
+	c := color.RGBA{uint8(n >> 16), uint8(n >> 8), uint8(n), 0xff}
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = c.R, c.G, c.B, c.A
+	}
+}
+
+func TestTripleBufferConcurrent(t *testing.T) {
+	const frames = 2000
+	bounds := image.Rect(0, 0, 16, 8)
+	b := NewTripleBuffer(bounds)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		img := image.NewRGBA(bounds)
+		for n := 1; n <= frames; n++ {
+			fillFrame(img, n)
+			b.Publish(img)
+		}
+	}()
+	// the consumer reads frames while the producer publishes them, each
+	// must be a whole frame no older than the last one read
+	last, reads := 0, 0
+	for last < frames {
+		n, whole := frameNumber(b.Front())
+		if !whole {
+			t.Fatalf("read a torn frame after frame %d", last)
+		}
+		if n < last {
+			t.Fatalf("read frame %d after frame %d", n, last)
+		}
+		last = n
+		reads++
+	}
+	wg.Wait()
+	if n, _ := frameNumber(b.Front()); n != frames {
+		t.Errorf("the front frame is %d after the last frame %d was published", n, frames)
+	}
+	t.Logf("%d reads of %d frames", reads, frames)
+}
+
+func TestTripleBufferResize(t *testing.T) {
+	b := NewTripleBuffer(image.Rect(0, 0, 4, 4))
+	img := image.NewRGBA(image.Rect(0, 0, 6, 3))
+	fillFrame(img, 7)
+	b.Publish(img)
+	front := b.Front()
+	if front.Bounds() != img.Bounds() {
+		t.Fatalf("front frame bounds %v, want %v", front.Bounds(), img.Bounds())
+	}
+	if n, whole := frameNumber(front); !whole || n != 7 {
+		t.Errorf("front frame is %d, want 7", n)
+	}
+	// without a new frame the consumer keeps the same one
+	if b.Front() != front {
+		t.Error("Front() changed without a new frame")
+	}
+}
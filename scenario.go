@@ -0,0 +1,451 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Region is a rectangle in the simulation area
+type Region struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// validate returns an error naming field if r is not inside the simulation
+// area of c
+func (r *Region) validate(c *Config, field string) error {
+	switch {
+	case r.Width <= 0 || r.Height <= 0:
+		return fmt.Errorf("config: %s: width and height must be positive", field)
+	case r.X < 0 || r.Y < 0 || r.X+r.Width > float64(c.Width) || r.Y+r.Height > float64(c.Height):
+		return fmt.Errorf("config: %s: the region must be inside the %dx%d simulation area",
+			field, c.Width, c.Height)
+	}
+	return nil
+}
+
+// scaled returns a copy of r scaled by sx, sy, for resizing the simulation
+func (r *Region) scaled(sx, sy float64) *Region {
+	return &Region{X: r.X * sx, Y: r.Y * sy, Width: r.Width * sx, Height: r.Height * sy}
+}
+
+// transposed returns a copy of r with its x and y coordinates swapped
+func (r Region) transposed() Region {
+	return Region{X: r.Y, Y: r.X, Width: r.Height, Height: r.Width}
+}
+
+// transpose swaps the width and height of the simulation area of c, and the
+// x and y coordinates of everything placed in it
+func (c *Config) transpose() {
+	c.Width, c.Height = c.Height, c.Width
+	c.Layout.transpose()
+	// the slices may be shared with other configs, so swap copies
+	c.SpawnRegions = append([]Region(nil), c.SpawnRegions...)
+	for i := range c.SpawnRegions {
+		c.SpawnRegions[i] = c.SpawnRegions[i].transposed()
+	}
+	c.Spawners = append([]ObstacleSpawner(nil), c.Spawners...)
+	for i := range c.Spawners {
+		if r := c.Spawners[i].Region; r != nil {
+			t := r.transposed()
+			c.Spawners[i].Region = &t
+		}
+	}
+}
+
+// ObstacleSpawner keeps up to Count moving obstacles in the simulation,
+// replacing them as they leave the area
+type ObstacleSpawner struct {
+	Count int `json:"count"`
+	// The number of ticks between spawning each obstacle while there are
+	// less than Count, zero spawns all that are missing at once
+	Interval int `json:"interval,omitempty"`
+	// The names of the kinds and motions of the obstacles, chosen from with
+	// equal chances, see ObstacleKind and ObstacleMotion
+	Kinds   []string `json:"kinds,omitempty"`
+	Motions []string `json:"motions,omitempty"`
+	// Multipliers for the speed and length of the obstacles, zero is one
+	Speed  float64 `json:"speed,omitempty"`
+	Length float64 `json:"length,omitempty"`
+	// Where the obstacles appear and patrol, the whole simulation area
+	// if nil
+	Region *Region `json:"region,omitempty"`
+}
+
+// obstacleSpawners returns the obstacle spawners of c, which are a single
+// spawner from NumObstacles, ObstacleKinds and ObstacleMotions if c has
+// no Spawners
+func (c *Config) obstacleSpawners() []ObstacleSpawner {
+	if len(c.Spawners) == 0 {
+		return []ObstacleSpawner{{
+			Count:   c.NumObstacles,
+			Kinds:   c.ObstacleKinds,
+			Motions: c.ObstacleMotions,
+		}}
+	}
+	return append([]ObstacleSpawner(nil), c.Spawners...)
+}
+
+// validateSpawns returns an error naming the first invalid field of the
+// obstacle spawners and creature spawn regions of c, or nil if they are valid
+func (c *Config) validateSpawns() error {
+	for i := range c.Spawners {
+		sp := &c.Spawners[i]
+		field := fmt.Sprintf("spawners[%d]", i)
+		switch {
+		case sp.Count < 0:
+			return fmt.Errorf("config: %s.count must not be negative", field)
+		case sp.Interval < 0:
+			return fmt.Errorf("config: %s.interval must not be negative", field)
+		case sp.Speed < 0:
+			return fmt.Errorf("config: %s.speed must not be negative", field)
+		case sp.Length < 0:
+			return fmt.Errorf("config: %s.length must not be negative", field)
+		}
+		for j, k := range sp.Kinds {
+			if _, err := ParseObstacleKind(k); err != nil {
+				return fmt.Errorf("config: %s.kinds[%d]: %v", field, j, err)
+			}
+		}
+		for j, m := range sp.Motions {
+			if _, err := ParseObstacleMotion(m); err != nil {
+				return fmt.Errorf("config: %s.motions[%d]: %v", field, j, err)
+			}
+		}
+		if sp.Region != nil {
+			if err := sp.Region.validate(c, field+".region"); err != nil {
+				return err
+			}
+		}
+	}
+	for i := range c.SpawnRegions {
+		if err := c.SpawnRegions[i].validate(c, fmt.Sprintf("spawn_regions[%d]", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// spawnObstacles tops up the obstacles of each spawner
+func (s *Sim) spawnObstacles() {
+	for i := range s.spawners {
+		sp := &s.spawners[i]
+		n := 0
+		for j := range s.obstacles {
+			if s.obstacles[j].spawner == i {
+				n++
+			}
+		}
+		if n >= sp.Count {
+			continue
+		}
+		if sp.Interval > 0 {
+			if s.tickCounter-s.lastSpawn[i] < sp.Interval {
+				continue
+			}
+			n = sp.Count - 1
+		}
+		s.lastSpawn[i] = s.tickCounter
+		for ; n < sp.Count; n++ {
+			s.obstacles = append(s.obstacles, s.newObstacle(i))
+		}
+	}
+}
+
+// maxSpawnTries is the number of positions randomCreaturePosition draws
+// before settling for one that touches a wall
+const maxSpawnTries = 10
+
+// randomCreaturePosition returns a random position for spawning a creature
+// that does not touch a wall, redrawing up to maxSpawnTries times
+func (s *Sim) randomCreaturePosition() (x, y float64) {
+	for try := 0; try < maxSpawnTries; try++ {
+		x, y = s.randomSpawnPoint()
+		if _, dist := s.nearestWall(x, y); dist > float64(s.cfg.CreatureRadius) {
+			break
+		}
+	}
+	return x, y
+}
+
+// randomSpawnPoint returns a random point in one of the spawn regions, or
+// anywhere in the simulation area if there are none
+func (s *Sim) randomSpawnPoint() (x, y float64) {
+	regions := s.cfg.SpawnRegions
+	if len(regions) == 0 {
+		x = float64(s.rng.Intn(s.width-s.cfg.CreatureRadius) + s.cfg.CreatureRadius)
+		y = float64(s.rng.Intn(s.height-s.cfg.CreatureRadius) + s.cfg.CreatureRadius)
+		return x, y
+	}
+	r := &regions[0]
+	if len(regions) > 1 {
+		r = &regions[s.rng.Intn(len(regions))]
+	}
+	return r.X + s.rng.Float64()*r.Width, r.Y + s.rng.Float64()*r.Height
+}
+
+// endEpisode kills every creature and removes the obstacles, so that the
+// next episode starts afresh with creatures bred from the hall of fame
+func (s *Sim) endEpisode() {
+	for len(s.creatures) > 0 {
+		s.deaths = append(s.deaths, s.killCreature(len(s.creatures)-1, DeathByEpisode))
+	}
+	s.obstacles = s.obstacles[:0]
+	for i := range s.lastSpawn {
+		s.lastSpawn[i] = s.tickCounter - s.spawners[i].Interval
+	}
+}
+
+// builtinScenarios are the scenarios that can be loaded by name, in the
+// same format as scenario files
+var builtinScenarios = map[string]string{
+	// the default planks drifting across an open area
+	"default": `{}`,
+	// a mix of every kind of obstacle
+	"mixed": `{
+		"spawners": [
+			{"count": 4, "kinds": ["plank", "rotating_plank", "bar"]},
+			{"count": 3, "kinds": ["circle", "polygon"], "motions": ["bounce"]}
+		]
+	}`,
+	// horizontal corridors crossed by swaying planks
+	"corridors": `{
+		"layout": {
+			"corridors": [
+				{"x1": 40, "y1": 180, "x2": 365, "y2": 180, "gap": 60},
+				{"x1": 40, "y1": 360, "x2": 365, "y2": 360, "gap": 60},
+				{"x1": 40, "y1": 540, "x2": 365, "y2": 540, "gap": 60}
+			]
+		},
+		"spawners": [{"count": 5, "motions": ["sine"]}]
+	}`,
+	// a grid of pillars with bouncing circles
+	"pillars": `{
+		"layout": {
+			"pillars": [
+				{"x": 80, "y": 120, "radius": 14}, {"x": 200, "y": 120, "radius": 14}, {"x": 320, "y": 120, "radius": 14},
+				{"x": 80, "y": 280, "radius": 14}, {"x": 200, "y": 280, "radius": 14}, {"x": 320, "y": 280, "radius": 14},
+				{"x": 80, "y": 440, "radius": 14}, {"x": 200, "y": 440, "radius": 14}, {"x": 320, "y": 440, "radius": 14},
+				{"x": 80, "y": 600, "radius": 14}, {"x": 200, "y": 600, "radius": 14}, {"x": 320, "y": 600, "radius": 14}
+			]
+		},
+		"spawners": [{"count": 4, "kinds": ["circle"], "motions": ["bounce"]}]
+	}`,
+	// a maze haunted by slow obstacles chasing the creatures
+	"maze": `{
+		"layout": {
+			"mazes": [{"x": 22, "y": 40, "cols": 9, "rows": 16, "cell": 40, "seed": 1}]
+		},
+		"spawners": [{"count": 2, "kinds": ["circle"], "motions": ["chase"], "speed": 0.5, "length": 0.5}],
+		"spawn_regions": [{"x": 22, "y": 40, "width": 360, "height": 640}]
+	}`,
+	// a few planks at first, more and faster as the creatures improve
+	"curriculum": `{
+		"num_obstacles": 2,
+		"curriculum": {
+			"advance_scores": [200, 400, 700, 1000, 1500],
+			"collapse_deaths": 20, "cooldown_cycles": 5,
+			"count_step": 2, "speed_step": 0.2, "length_step": 0.1
+		}
+	}`,
+	// creatures start at the bottom below patrolling bars and a chaser,
+	// and everything restarts every 3000 ticks
+	"gauntlet": `{
+		"spawners": [
+			{"count": 4, "kinds": ["bar"], "motions": ["patrol"]},
+			{"count": 1, "interval": 300, "kinds": ["polygon"], "motions": ["chase"], "speed": 0.6}
+		],
+		"spawn_regions": [{"x": 0, "y": 600, "width": 405, "height": 120}],
+		"episode_ticks": 3000
+	}`,
+}
+
+// builtinScenarioNames returns the sorted names of the built-in scenarios
+func builtinScenarioNames() []string {
+	var names []string
+	for name := range builtinScenarios {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadScenario loads the built-in scenario with the given name, or else the
+// scenario file at the path name. Like LoadConfig the scenario is not
+// validated.
+func LoadScenario(name string) (Config, error) {
+	if js, ok := builtinScenarios[name]; ok {
+		return decodeConfig(name, []byte(js))
+	}
+	c, err := LoadConfig(name)
+	if os.IsNotExist(err) {
+		return c, fmt.Errorf("config: %q is not a scenario file or one of the built-in scenarios: %s",
+			name, strings.Join(builtinScenarioNames(), ", "))
+	}
+	return c, err
+}
+
+// LoadConfig reads a JSON configuration from path, fields missing from the
+// file keep their default values. The configuration is not validated so
+// that flags may still override it.
+func LoadConfig(path string) (Config, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return DefaultConfig(), err
+	}
+	return decodeConfig(path, b)
+}
+
+// decodeConfig decodes the JSON configuration b over the defaults, the
+// errors name the source of b and the position or field in it at fault
+func decodeConfig(name string, b []byte) (Config, error) {
+	c := DefaultConfig()
+	if err := json.Unmarshal(b, &c); err != nil {
+		switch err := err.(type) {
+		case *json.SyntaxError:
+			line, col := lineCol(b, err.Offset)
+			return c, fmt.Errorf("config: %s:%d:%d: %v", name, line, col, err)
+		case *json.UnmarshalTypeError:
+			line, col := lineCol(b, err.Offset)
+			return c, fmt.Errorf("config: %s:%d:%d: %v", name, line, col, err)
+		}
+		return c, fmt.Errorf("config: %s: %v", name, err)
+	}
+	// misspelt fields would otherwise silently keep their defaults
+	var v interface{}
+	json.Unmarshal(b, &v)
+	if field := unknownField(v, reflect.TypeOf(c), ""); field != "" {
+		return c, fmt.Errorf("config: %s: unknown field %s", name, field)
+	}
+	return c, nil
+}
+
+// lineCol returns the line and column of the byte at offset in b
+func lineCol(b []byte, offset int64) (line, col int) {
+	if offset > int64(len(b)) {
+		offset = int64(len(b))
+	}
+	line, col = 1, 1
+	for _, c := range b[:offset] {
+		if c == '\n' {
+			line, col = line+1, 1
+		} else {
+			col++
+		}
+	}
+	return line, col
+}
+
+// unknownField returns the path of the first field in the decoded JSON
+// value v that the type t has no field for, or "" if there is none
+func unknownField(v interface{}, t reflect.Type, path string) string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if t.Kind() != reflect.Struct {
+			return ""
+		}
+		var keys []string
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			field := k
+			if path != "" {
+				field = path + "." + k
+			}
+			f, ok := jsonField(t, k)
+			if !ok {
+				return field
+			}
+			if u := unknownField(v[k], f.Type, field); u != "" {
+				return u
+			}
+		}
+	case []interface{}:
+		if t.Kind() != reflect.Slice {
+			return ""
+		}
+		for i, e := range v {
+			if u := unknownField(e, t.Elem(), fmt.Sprintf("%s[%d]", path, i)); u != "" {
+				return u
+			}
+		}
+	}
+	return ""
+}
+
+// jsonField returns the field of the struct type t that encoding/json
+// decodes the key name into. Like encoding/json it prefers an exact match of
+// the name to one differing in case, uses the Go names of untagged fields,
+// skips fields tagged "-" and looks in embedded structs after t's own fields.
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	var fold *reflect.StructField
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		key := strings.Split(tag, ",")[0]
+		if f.Anonymous && key == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, ft)
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			// unexported
+			continue
+		}
+		if key == "" {
+			key = f.Name
+		}
+		if key == name {
+			return f, true
+		}
+		if fold == nil && strings.EqualFold(key, name) {
+			fold = &f
+		}
+	}
+	if fold != nil {
+		return *fold, true
+	}
+	for _, e := range embedded {
+		if f, ok := jsonField(e, name); ok {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRandomCreaturePositionAvoidsWalls(t *testing.T) {
+	for _, name := range []string{"pillars", "maze", "corridors"} {
+		cfg, err := LoadScenario(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := NewSim(cfg, 1)
+		radius := float64(cfg.CreatureRadius)
+		for i := 0; i < 200; i++ {
+			x, y := s.randomCreaturePosition()
+			if _, dist := s.nearestWall(x, y); dist <= radius {
+				t.Errorf("%s: creature at (%.1f, %.1f) is %.1f from a wall", name, x, y, dist)
+			}
+		}
+	}
+}
+
+func TestRandomCreaturePositionBounded(t *testing.T) {
+	cfg := DefaultConfig()
+	s := NewSim(cfg, 1)
+	// a wall covering the whole area can not be avoided
+	s.addWall(0, float64(cfg.Height)/2, float64(cfg.Width), float64(cfg.Height)/2,
+		float64(2*(cfg.Width+cfg.Height)))
+	s.rng = rand.New(rand.NewSource(1))
+	s.randomCreaturePosition()
+	// each try draws an x and a y
+	want := rand.New(rand.NewSource(1))
+	for i := 0; i < maxSpawnTries; i++ {
+		want.Intn(cfg.Width - cfg.CreatureRadius)
+		want.Intn(cfg.Height - cfg.CreatureRadius)
+	}
+	if got, want := s.rng.Int63(), want.Int63(); got != want {
+		t.Errorf("randomCreaturePosition() did not draw %d positions", maxSpawnTries)
+	}
+}
+
+func TestPatrolRegion(t *testing.T) {
+	cfg := DefaultConfig()
+	region := Region{X: 20, Y: 40, Width: 60, Height: 30}
+	cfg.Spawners = []ObstacleSpawner{
+		{Count: 10, Motions: []string{"patrol"}, Kinds: []string{"circle", "bar"}, Region: &region},
+	}
+	s := NewSim(cfg, 1)
+	inside := func(x, y float64) bool {
+		return x >= region.X && x <= region.X+region.Width &&
+			y >= region.Y && y <= region.Y+region.Height
+	}
+	for i := range s.obstacles {
+		for _, p := range s.obstacles[i].waypoints {
+			if !inside(p.x, p.y) {
+				t.Errorf("obstacle %d patrols to (%.1f, %.1f) outside the region",
+					s.obstacles[i].id, p.x, p.y)
+			}
+		}
+	}
+	// once at its first waypoint an obstacle stays between waypoints
+	for i := range s.obstacles {
+		o := &s.obstacles[i]
+		for j := 0; j < 500; j++ {
+			o.step(s)
+		}
+		if x, y := o.middle(); !inside(x, y) {
+			t.Errorf("obstacle %d is at (%.1f, %.1f) outside the region", o.id, x, y)
+		}
+	}
+}
+
+func TestDecodeConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		err  string // the start of the error, or "" for none
+	}{
+		{"valid", `{"width": 500, "spawners": [{"count": 2, "kinds": ["circle"]}]}`, ""},
+		{"syntax", "{\n  \"width\": 500,\n  \"height\": }",
+			"config: test.json:3:14: invalid character '}' looking for beginning of value"},
+		{"type", "{\n\t\"width\": \"wide\"\n}",
+			"config: test.json:2:17: json: cannot unmarshal string into Go "},
+		{"unknown", `{"widht": 500}`,
+			"config: test.json: unknown field widht"},
+		{"unknown nested", `{"layout": {"maze": []}}`,
+			"config: test.json: unknown field layout.maze"},
+		{"unknown in list", `{"spawners": [{"count": 1}, {"kind": "circle"}]}`,
+			"config: test.json: unknown field spawners[1].kind"},
+		{"unknown in nested list", `{"layout": {"mazes": [{"cols": 2, "colums": 3}]}}`,
+			"config: test.json: unknown field layout.mazes[0].colums"},
+		{"other case", `{"Width": 500, "SPAWNERS": [{"Count": 1}]}`, ""},
+		{"ignored field", `{"width": 500, "layout": {"mazes": [{"transposed": true}]}}`,
+			"config: test.json: unknown field layout.mazes[0].transposed"},
+	}
+	for _, test := range tests {
+		c, err := decodeConfig("test.json", []byte(test.json))
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		case test.err != "" && err == nil:
+			t.Errorf("%s: expected error %q", test.name, test.err)
+		case test.err != "" && !strings.HasPrefix(err.Error(), test.err):
+			t.Errorf("%s: got error %q, expected it to start with %q", test.name, err, test.err)
+		}
+		if test.err == "" && (c.Width != 500 || c.Height != DefaultConfig().Height) {
+			t.Errorf("%s: got %dx%d, expected the width decoded over the defaults",
+				test.name, c.Width, c.Height)
+		}
+	}
+}
+
+func TestUnknownField(t *testing.T) {
+	type inner struct {
+		Value int `json:"value"`
+	}
+	type Embedded struct {
+		Promoted int `json:"promoted"`
+	}
+	type outer struct {
+		Embedded
+		*inner
+		Tagged   int `json:"tagged,omitempty"`
+		Untagged int
+		Ignored  int `json:"-"`
+		Dash     int `json:"-,"`
+		Nested   []inner
+		private  int
+	}
+	tests := []struct {
+		json, field string
+	}{
+		{`{"tagged": 1, "Untagged": 2, "untagged": 3, "-": 4}`, ""},
+		{`{"TAGGED": 1}`, ""},
+		{`{"Tagged": 1}`, ""},
+		{`{"promoted": 1, "Promoted": 2, "value": 3}`, ""},
+		{`{"Embedded": {}}`, "Embedded"},
+		{`{"Ignored": 1}`, "Ignored"},
+		{`{"private": 1}`, "private"},
+		{`{"nested": [{"value": 1}, {"valeu": 2}]}`, "nested[1].valeu"},
+		{`{"untaged": 1}`, "untaged"},
+	}
+	for _, test := range tests {
+		var v interface{}
+		if err := json.Unmarshal([]byte(test.json), &v); err != nil {
+			t.Fatal(err)
+		}
+		if got := unknownField(v, reflect.TypeOf(outer{}), ""); got != test.field {
+			t.Errorf("unknownField(%s) = %q, expected %q", test.json, got, test.field)
+		}
+	}
+}
+
+func TestLineCol(t *testing.T) {
+	b := []byte("ab\n\ncde\n")
+	tests := []struct {
+		offset    int64
+		line, col int
+	}{
+		{0, 1, 1},
+		{2, 1, 3},
+		{3, 2, 1},
+		{4, 3, 1},
+		{6, 3, 3},
+		{100, 4, 1}, // past the end
+	}
+	for _, test := range tests {
+		line, col := lineCol(b, test.offset)
+		if line != test.line || col != test.col {
+			t.Errorf("lineCol(%d) = %d:%d, expected %d:%d",
+				test.offset, line, col, test.line, test.col)
+		}
+	}
+}
+
+func TestBuiltinScenarios(t *testing.T) {
+	for _, name := range builtinScenarioNames() {
+		cfg, err := LoadScenario(name)
+		if err == nil {
+			err = cfg.Validate()
+		}
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
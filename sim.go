@@ -23,27 +23,8 @@ import (
 	"math/rand"
 	"sort"
 
+	"github.com/llgcode/draw2d"
 	"github.com/llgcode/draw2d/draw2dimg"
-	"github.com/llgcode/draw2d/draw2dkit"
-)
-
-const (
-	// The radius of the simulated "creature" and a float version
-	creatureRadius  = 6
-	creatureRadiusf = float64(creatureRadius)
-	// The thickness of the moving obstacle lines:
-	obstacleWidth = 3
-	// The minimum number of creatures that must be alive:
-	minCreatures = 10
-	// The number of obstacles to spawn:
-	numObstacles = 6
-	// maxCreatures is the limit for automatically spawning creatures:
-	maxCreatures = 2 * minCreatures
-	// maxBestCreatures is the limit for the creature hall of fame for
-	// evolution:
-	maxBestCreatures = maxCreatures * 2
-	// The number of simulation ticks between "evolution" spawning:
-	evolutionCycleTicks = 30 * 5
 )
 
 var (
@@ -52,14 +33,61 @@ var (
 	Black   = color.RGBA{0, 0, 0, 0xFF}
 )
 
+// DeathCause describes what a creature touched to die
+type DeathCause int
+
+const (
+	// DeathByBorder is for creatures that touched the simulation border
+	DeathByBorder DeathCause = iota
+	// DeathByObstacle is for creatures that touched a moving obstacle
+	DeathByObstacle
+	// DeathByWall is for creatures that touched a static wall
+	DeathByWall
+	// DeathByUser is for creatures killed by an Input
+	DeathByUser
+	// DeathByEpisode is for creatures alive at the end of an episode, see
+	// Config.EpisodeTicks
+	DeathByEpisode
+	// numDeathCauses is the number of DeathCause values
+	numDeathCauses
+)
+
+func (d DeathCause) String() string {
+	switch d {
+	case DeathByBorder:
+		return "border"
+	case DeathByObstacle:
+		return "obstacle"
+	case DeathByWall:
+		return "wall"
+	case DeathByUser:
+		return "user"
+	case DeathByEpisode:
+		return "episode"
+	}
+	return "unknown"
+}
+
 // Creature holds the state for a simulated "creature"
 type Creature struct {
+	id    int // Unique identifier, assigned each time the creature spawns
 	x     float64
 	y     float64
 	angle float64
 	score int64
 	color color.Color
 	brain *Brain
+	born  int // The tick the creature was spawned at
+	// The origin of the creature's brain weights
+	lineage Lineage
+	// The brain inputs and outputs from the last call to GetAction, and the
+	// position and angle the inputs were sensed from
+	sensors     []float64
+	turn        float64
+	move        float64
+	sensorX     float64
+	sensorY     float64
+	sensorAngle float64
 }
 
 // GetAction returns the brain output for the creature at the current
@@ -71,17 +99,46 @@ func (c *Creature) GetAction(s *Sim) (turn, move float64) {
 		angle := math.Pi * 2 * float64(i) / numBrainInputs
 		s.brainInputs[i] = s.DistanceToNearest(c, angle)
 	}
-	return c.brain.Step(s.brainInputs)
+	copy(c.sensors, s.brainInputs)
+	c.sensorX, c.sensorY, c.sensorAngle = c.x, c.y, c.angle
+	c.turn, c.move = c.brain.Step(s.brainInputs)
+	return c.turn, c.move
 }
 
 // Obstacle holds the state for simulated moving obstacle
 type Obstacle struct {
+	id      int // Unique identifier for death records
+	spawner int // The index of the ObstacleSpawner that spawned the obstacle
+	kind    ObstacleKind
+	// The start of planks and bars, the center of circles and polygons
 	x      float64
 	y      float64
 	angle  float64
 	dx     float64
 	dy     float64
-	length float64
+	length float64 // The diameter of circles and polygons
+	spin   float64 // The change in angle per tick of rotating planks
+	sides  int     // The number of sides of polygons
+	// The change in length per tick and the longest length of bars
+	growth    float64
+	maxLength float64
+	motion    ObstacleMotion
+	// The distance to each side, change in phase per tick and phase of
+	// the sway of MotionSine
+	sway, swayRate, swayPhase float64
+	// The loop of positions of MotionPatrol and the index of the next one
+	waypoints []waypoint
+	waypoint  int
+}
+
+// Lineage identifies a genome, a set of Brain weights, and records the
+// genomes it was bred from
+type Lineage struct {
+	GenomeID int `json:"genome_id"`
+	// The genomes the weights were combined from, zero for random genomes
+	Parents [2]int `json:"parents"`
+	// Zero for random genomes, one more than the parents' otherwise
+	Generation int `json:"generation"`
 }
 
 // TopCreature is for tracking the Brain weights of Creatures
@@ -89,6 +146,7 @@ type Obstacle struct {
 type TopCreature struct {
 	score   int64
 	weights []float64
+	lineage Lineage
 }
 
 // WeightsEqual returns true if the the TopCreature's weights match
@@ -139,27 +197,62 @@ type Sim struct {
 	creatures     []*Creature  // The currently alive creatures
 	creaturePool  []*Creature  // For recycling dead creatures
 	obstacles     []Obstacle   // The moving obstacles
+	walls         []Wall       // The static walls
 	bestCreatures TopCreatures // All time best brain patterns and scores
-	CurrentFrame  *image.RGBA  // The framebuffer for drawing the simulation
+	// The sources of the obstacles and the tick each last spawned at
+	spawners  []ObstacleSpawner
+	lastSpawn []int
+	// The difficulty level of the curriculum, the evolution cycles until it
+	// may change again, and the deaths during this cycle, see Curriculum
+	level         int
+	levelCooldown int
+	cycleDeaths   int
+	// The hall of fame median at the last collapse of the curriculum level,
+	// or -Inf before the first collapse
+	collapseMedian float64
+	// The collision buffer holding the border and obstacles,
+	// see DistanceToNearest
+	frame *image.RGBA
 	// The frame width, height, and border size as floats so we can cache them:
 	frameWidthf  float64
 	frameHeightf float64
 	borderWidthf float64
-	gc           *draw2dimg.GraphicContext // The draw2d context
+	gc           draw2d.GraphicContext // The draw2d context for frame
 	// Used for storing the inputs to the brain, as the size does not change
 	// and we only ever need to do one brain at a time, no reason to keep
 	// allocating this elsewhere.
 	brainInputs []float64
-	tickCounter int // For counting the number of elapsed ticks
+	tickCounter int                   // For counting the number of elapsed ticks
+	deathCounts [numDeathCauses]int64 // The number of deaths for each cause
+	deaths      []DeathRecord         // The deaths during the last tick
+	// For assigning unique ids to creatures, genomes, obstacles and walls
+	nextCreatureID int
+	nextGenomeID   int
+	nextObstacleID int
+	nextWallID     int
+	// The current best creature in the hall of fame, for detecting changes
+	bestCreature *TopCreature
+	observers    []Observer // Registered with AddObserver
+	cfg          Config     // The size and tuning parameters
+	// The source of all randomness in the simulation, so that runs
+	// with the same seed and inputs are reproducible
+	seed int64
+	rng  *rand.Rand
+	// The world state for the renderer and drawing the collision buffer
+	state    WorldState
+	renderer Renderer
 }
 
-// NewSim creates a new Sim with a worldsize (width, height)
-// surrounded by a blank area of borderWidth
-func NewSim(width, height, borderWidth int) *Sim {
+// NewSim creates a new Sim configured by cfg with a worldsize
+// (cfg.Width, cfg.Height) surrounded by a blank area of cfg.BorderWidth,
+// using seed for all random numbers in the simulation. cfg must be valid,
+// see Config.Validate.
+func NewSim(cfg Config, seed int64) *Sim {
+	width, height, borderWidth := cfg.Width, cfg.Height, cfg.BorderWidth
 	buffer := image.NewRGBA(image.Rect(0, 0, width+borderWidth*2, height+borderWidth*2))
 	bounds := buffer.Bounds()
 	gc := draw2dimg.NewGraphicContext(buffer)
-	return &Sim{
+	s := &Sim{
 		width:         width,
 		height:        height,
 		borderWidth:   borderWidth,
@@ -167,26 +260,43 @@ func NewSim(width, height, borderWidth int) *Sim {
 		creaturePool:  make([]*Creature, 0),
 		obstacles:     make([]Obstacle, 0),
 		bestCreatures: make(TopCreatures, 0),
-		CurrentFrame:  buffer,
+		frame:         buffer,
 		frameWidthf:   float64(bounds.Dx()),
 		frameHeightf:  float64(bounds.Dy()),
 		borderWidthf:  float64(borderWidth),
 		gc:            gc,
 		brainInputs:   make([]float64, numBrainInputs),
 		tickCounter:   0,
+		deaths:        make([]DeathRecord, 0),
+		seed:          seed,
+		rng:           rand.New(rand.NewSource(seed)),
+		renderer:      NopRenderer{},
+		cfg:           cfg,
+		spawners:      cfg.obstacleSpawners(),
+	}
+	s.collapseMedian = math.Inf(-1)
+	s.lastSpawn = make([]int, len(s.spawners))
+	for i := range s.spawners {
+		s.lastSpawn[i] = -s.spawners[i].Interval
 	}
+	s.addLayout(&cfg.Layout)
+	s.updateState()
+	return s
 }
 
 // NewRandomCreature returns a new completely randomized Creature with a valid
 // location within the simulation
 func (s *Sim) NewRandomCreature() *Creature {
-	b := NewRandomBrain()
+	b := NewRandomBrain(s.rng)
+	x, y := s.randomCreaturePosition()
 	return &Creature{
-		x:     float64(rand.Intn(s.width-creatureRadius) + creatureRadius),
-		y:     float64(rand.Intn(s.height-creatureRadius) + creatureRadius),
-		angle: rand.Float64() * 2 * math.Pi,
-		color: b.GetColor(),
-		brain: b,
+		x:       x,
+		y:       y,
+		angle:   s.rng.Float64() * 2 * math.Pi,
+		color:   b.GetColor(),
+		brain:   b,
+		born:    s.tickCounter,
+		sensors: make([]float64, numBrainInputs),
 	}
 }
 
@@ -194,36 +304,58 @@ func (s *Sim) NewRandomCreature() *Creature {
 // from the provided weights and a valid location within the simulation
 func (s *Sim) NewRandomCreatureWithWeights(weights []float64) *Creature {
 	b := NewBrainFromWeights(weights)
+	x, y := s.randomCreaturePosition()
 	return &Creature{
-		x:     float64(rand.Intn(s.width-creatureRadius) + creatureRadius),
-		y:     float64(rand.Intn(s.height-creatureRadius) + creatureRadius),
-		angle: rand.Float64() * 2 * math.Pi,
-		color: b.GetColor(),
-		brain: b,
+		x:       x,
+		y:       y,
+		angle:   s.rng.Float64() * 2 * math.Pi,
+		color:   b.GetColor(),
+		brain:   b,
+		born:    s.tickCounter,
+		sensors: make([]float64, numBrainInputs),
 	}
 }
 
-// NewRandomObstacle returns a new randomized obstacle with a valid location
-// within the simulation
-func (s *Sim) NewRandomObstacle() Obstacle {
-	dx := rand.Float64()*2 - 1
-	dy := rand.Float64()*2 - 1
+// newObstacle returns a new randomized obstacle from the spawner at index
+// spawner with a valid location within the simulation
+func (s *Sim) newObstacle(spawner int) Obstacle {
+	sp := &s.spawners[spawner]
+	dx := s.rng.Float64()*2 - 1
+	dy := s.rng.Float64()*2 - 1
 	for dx == 0 {
-		dx = rand.Float64()*2 - 1
+		dx = s.rng.Float64()*2 - 1
 	}
 	for dy == 0 {
-		dy = rand.Float64()*2 - 1
+		dy = s.rng.Float64()*2 - 1
 	}
 	dx += math.Copysign(0.5, dx)
 	dy += math.Copysign(0.5, dy)
-	return Obstacle{
-		x:      float64(rand.Intn(s.width)),
-		y:      float64(rand.Intn(s.width)),
-		angle:  rand.Float64() * 2 * math.Pi,
-		dx:     dx,
-		dy:     dy,
-		length: float64(rand.Intn(s.width))/3 + float64(s.width)/6,
+	kind := s.randomObstacleKind(sp.Kinds)
+	motion := s.randomObstacleMotion(sp.Motions)
+	s.nextObstacleID++
+	o := Obstacle{
+		id:      s.nextObstacleID,
+		spawner: spawner,
+	}
+	if sp.Region == nil {
+		o.x = float64(s.rng.Intn(s.width))
+		o.y = float64(s.rng.Intn(s.height))
+	} else {
+		o.x = sp.Region.X + s.rng.Float64()*sp.Region.Width
+		o.y = sp.Region.Y + s.rng.Float64()*sp.Region.Height
 	}
+	o.angle = s.rng.Float64() * 2 * math.Pi
+	o.dx, o.dy = dx, dy
+	o.length = float64(s.rng.Intn(s.width))/3 + float64(s.width)/6
+	if sp.Speed != 0 {
+		o.dx, o.dy = o.dx*sp.Speed, o.dy*sp.Speed
+	}
+	if sp.Length != 0 {
+		o.length *= sp.Length
+	}
+	o.setKind(s, kind)
+	o.setMotion(s, motion)
+	return o
 }
 
 // shuffleCreatures shuffles the creature list using the Durstenfeld
@@ -231,7 +363,7 @@ func (s *Sim) NewRandomObstacle() Obstacle {
 // See: https://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle
 func (s *Sim) shuffleCreatures() {
 	for i := len(s.creatures) - 1; i > 0; i-- {
-		j := rand.Intn(i + 1)
+		j := s.rng.Intn(i + 1)
 		s.creatures[i], s.creatures[j] = s.creatures[j], s.creatures[i]
 	}
 }
@@ -243,37 +375,60 @@ func (s *Sim) SpawnRandomCreature() {
 	lenCreaturePool := len(s.creaturePool)
 	if lenCreaturePool > 0 {
 		c := s.creaturePool[lenCreaturePool-1]
-		c.brain.RandomizeWeights()
+		c.brain.RandomizeWeights(s.rng)
 		c.color = c.brain.GetColor()
-		c.x = float64(rand.Intn(s.width-creatureRadius) + creatureRadius)
-		c.y = float64(rand.Intn(s.height-creatureRadius) + creatureRadius)
-		c.angle = rand.Float64() * 2 * math.Pi
+		c.x, c.y = s.randomCreaturePosition()
+		c.angle = s.rng.Float64() * 2 * math.Pi
+		c.born = s.tickCounter
+		c.turn, c.move = 0, 0
 		s.creatures = append(s.creatures, c)
 		s.creaturePool[lenCreaturePool-1] = nil
 		s.creaturePool = s.creaturePool[:lenCreaturePool-1]
 	} else {
 		s.creatures = append(s.creatures, s.NewRandomCreature())
 	}
+	s.nextGenomeID++
+	s.spawned(Lineage{GenomeID: s.nextGenomeID})
 }
 
 // SpawnCreatureWithWeights adds a new random creature with a brain from the
 // provided weights to the simulation, if possible it will re-initialize a
 // creature from the creaturePool instead of allocating a new one.
+// The weights are given a new genome id.
 func (s *Sim) SpawnCreatureWithWeights(weights []float64) {
+	s.nextGenomeID++
+	s.SpawnCreatureWithLineage(weights, Lineage{GenomeID: s.nextGenomeID})
+}
+
+// SpawnCreatureWithLineage is like SpawnCreatureWithWeights but keeps the
+// genome id and ancestry of the weights
+func (s *Sim) SpawnCreatureWithLineage(weights []float64, lineage Lineage) {
 	lenCreaturePool := len(s.creaturePool)
 	if lenCreaturePool > 0 {
 		c := s.creaturePool[lenCreaturePool-1]
 		c.brain.SetWeights(weights)
 		c.color = c.brain.GetColor()
-		c.x = float64(rand.Intn(s.width-creatureRadius) + creatureRadius)
-		c.y = float64(rand.Intn(s.height-creatureRadius) + creatureRadius)
-		c.angle = rand.Float64() * 2 * math.Pi
+		c.x, c.y = s.randomCreaturePosition()
+		c.angle = s.rng.Float64() * 2 * math.Pi
+		c.born = s.tickCounter
+		c.turn, c.move = 0, 0
 		s.creatures = append(s.creatures, c)
 		s.creaturePool[lenCreaturePool-1] = nil
 		s.creaturePool = s.creaturePool[:lenCreaturePool-1]
 	} else {
 		s.creatures = append(s.creatures, s.NewRandomCreatureWithWeights(weights))
 	}
+	s.spawned(lineage)
+}
+
+// spawned assigns the last spawned creature an id and lineage and notifies
+// the observers
+func (s *Sim) spawned(lineage Lineage) {
+	c := s.creatures[len(s.creatures)-1]
+	s.nextCreatureID++
+	c.id = s.nextCreatureID
+	c.lineage = lineage
+	s.notifySpawn(c)
 }
 
 // SpawnCreatures adds n new creatures to the simulation.
@@ -294,23 +449,35 @@ func (s *Sim) SpawnCreatures(n int) {
 		for ; i < nToSpawn; i++ {
 			weights := make([]float64, lWeights)
 			copy(weights, s.bestCreatures[i%lBestCreatures].weights)
-			s.SpawnCreatureWithWeights(weights)
+			s.SpawnCreatureWithLineage(weights, s.bestCreatures[i%lBestCreatures].lineage)
 		}
 		if i == n {
 			return
 		}
 		// spawn mixed versions
-		for offset := 0; i < n/4; i++ {
+		for offset := 0; i < n*3/4; i++ {
 			weights := make([]float64, lWeights)
 			j := 0
-			divider := rand.Intn(lWeights)
+			divider := s.rng.Intn(lWeights)
+			a := s.bestCreatures[(offset)%lBestCreatures]
+			b := s.bestCreatures[(offset+1)%lBestCreatures]
 			for ; j < divider; j++ {
-				weights[j] = s.bestCreatures[(offset)%lBestCreatures].weights[j]
+				weights[j] = a.weights[j]
 			}
 			for ; j < lWeights; j++ {
-				weights[j] = s.bestCreatures[(offset+1)%lBestCreatures].weights[j]
+				weights[j] = b.weights[j]
+			}
+			s.mutate(weights)
+			s.nextGenomeID++
+			lineage := Lineage{
+				GenomeID:   s.nextGenomeID,
+				Parents:    [2]int{a.lineage.GenomeID, b.lineage.GenomeID},
+				Generation: a.lineage.Generation + 1,
+			}
+			if b.lineage.Generation >= a.lineage.Generation {
+				lineage.Generation = b.lineage.Generation + 1
 			}
-			s.SpawnCreatureWithWeights(weights)
+			s.SpawnCreatureWithLineage(weights, lineage)
 			offset++
 		}
 	}
@@ -320,10 +487,16 @@ func (s *Sim) SpawnCreatures(n int) {
 	}
 }
 
-// SpawnObstacles adds n new random obstacles to the simulation
-func (s *Sim) SpawnObstacles(n int) {
-	for i := 0; i < n; i++ {
-		s.obstacles = append(s.obstacles, s.NewRandomObstacle())
+// mutate replaces each of weights with a random weight with a chance of
+// the configured mutation rate
+func (s *Sim) mutate(weights []float64) {
+	if s.cfg.MutationRate <= 0 {
+		return
+	}
+	for i := range weights {
+		if s.rng.Float64() < s.cfg.MutationRate {
+			weights[i] = s.rng.Float64()*2 - 1
+		}
 	}
 }
 
@@ -343,11 +516,14 @@ func (s *Sim) DistanceToNearest(c *Creature, angle float64) float64 {
 	dist := math.MaxFloat64
 	ax := math.Cos(angle + c.angle)
 	ay := math.Sin(angle + c.angle)
-	x := c.x + ax
-	y := c.y + ay
+	// the creature's position in the frame
+	cx := s.borderWidthf + c.x
+	cy := s.borderWidthf + c.y
+	x := cx + ax
+	y := cy + ay
 	for x < s.frameWidthf && x >= 0 && y < s.frameHeightf && y >= 0 {
-		if s.CurrentFrame.At(int(x), int(y)) != BGColor {
-			dist = xyDist(c.x, c.y, x, y)
+		if s.frame.At(int(x), int(y)) != BGColor {
+			dist = xyDist(cx, cy, x, y)
 			break
 		}
 		x += ax
@@ -356,36 +532,165 @@ func (s *Sim) DistanceToNearest(c *Creature, angle float64) float64 {
 	return dist
 }
 
-// DoTick runs the simulation by a single tick including drawing the new frame
-// to s.CurrentFrame
+// SetRenderer sets the Renderer used to draw the simulation after each tick,
+// new Sims use a NopRenderer
+func (s *Sim) SetRenderer(r Renderer) {
+	s.renderer = r
+}
+
+// State returns the world state after the last tick or input, which must
+// not be modified and is only valid until the next call to DoTick or
+// ApplyInput
+func (s *Sim) State() *WorldState {
+	return &s.state
+}
+
+// FrameBounds returns the size of a frame holding the simulation area
+// and the border
+func (s *Sim) FrameBounds() image.Rectangle {
+	return s.frame.Bounds()
+}
+
+// updateState copies the current simulation state to s.state
+func (s *Sim) updateState() {
+	w := &s.state
+	w.Tick = s.tickCounter
+	w.Width = s.width
+	w.Height = s.height
+	w.BorderWidth = s.borderWidth
+	w.Cycle = s.tickCounter / s.cfg.EvolutionCycleTicks
+	w.CreatureRadius = float64(s.cfg.CreatureRadius)
+	w.ObstacleWidth = s.cfg.ObstacleWidth
+	w.Level = s.level
+	w.MaxLevel = len(s.cfg.Curriculum.AdvanceScores)
+	w.BestScore = 0
+	if len(s.bestCreatures) > 0 {
+		w.BestScore = s.bestCreatures[0].score
+	}
+	n := len(s.creatures)
+	if cap(w.Creatures) < n {
+		w.Creatures = append(w.Creatures[:cap(w.Creatures)],
+			make([]CreatureState, n-cap(w.Creatures))...)
+	}
+	w.Creatures = w.Creatures[:n]
+	for i, c := range s.creatures {
+		cs := &w.Creatures[i]
+		// the brain's slices change in place every tick, so copy them into
+		// the state's own, reusing those from the last tick
+		sensors, memory, weights := cs.Sensors, cs.Memory, cs.Weights
+		*cs = CreatureState{
+			ID:          c.id,
+			X:           c.x,
+			Y:           c.y,
+			Angle:       c.angle,
+			Color:       c.color,
+			Score:       c.score,
+			Born:        c.born,
+			Lineage:     c.lineage,
+			Sensors:     append(sensors[:0], c.sensors...),
+			SensorX:     c.sensorX,
+			SensorY:     c.sensorY,
+			SensorAngle: c.sensorAngle,
+			Turn:        c.turn,
+			Move:        c.move,
+			Memory:      append(memory[:0], c.brain.Memory()...),
+			Weights:     append(weights[:0], c.brain.GetWeights()...),
+		}
+	}
+	w.Walls = w.Walls[:0]
+	for i := range s.walls {
+		l := &s.walls[i]
+		w.Walls = append(w.Walls, WallState{
+			ID:    l.id,
+			X1:    l.x1,
+			Y1:    l.y1,
+			X2:    l.x2,
+			Y2:    l.y2,
+			Width: l.width,
+		})
+	}
+	w.Obstacles = w.Obstacles[:0]
+	for i := range s.obstacles {
+		w.Obstacles = append(w.Obstacles, s.obstacles[i].state())
+	}
+}
+
+// Config returns the configuration of the Sim, with the current size
+func (s *Sim) Config() Config {
+	return s.cfg
+}
+
+// Seed returns the seed the Sim was created with
+func (s *Sim) Seed() int64 {
+	return s.seed
+}
+
+// Deaths returns the records of the creatures that died during the last tick.
+// The returned slice is only valid until the next call to DoTick
+func (s *Sim) Deaths() []DeathRecord {
+	return s.deaths
+}
+
+// inBorder returns true if the frame pixel (x, y) is part of the border
+// drawn around the simulation area
+func (s *Sim) inBorder(x, y int) bool {
+	return x < s.borderWidth || y < s.borderWidth ||
+		x >= s.width+s.borderWidth || y >= s.height+s.borderWidth
+}
+
+// killCreature removes the live creature at index i after recording its
+// death by cause and updating the hall of fame, and returns the record
+func (s *Sim) killCreature(i int, cause DeathCause) DeathRecord {
+	c := s.creatures[i]
+	s.deathCounts[cause]++
+	if cause != DeathByUser && cause != DeathByEpisode {
+		s.cycleDeaths++
+	}
+	d := s.newDeathRecord(c, cause)
+	weights := c.brain.GetWeights()
+	index := s.bestCreatures.IndexOfWeights(weights)
+	if index == -1 {
+		s.bestCreatures = append(s.bestCreatures, &TopCreature{
+			weights: c.brain.GetWeights(),
+			score:   c.score,
+			lineage: c.lineage,
+		})
+	} else {
+		if s.bestCreatures[index].score < c.score {
+			s.bestCreatures[index].score = c.score
+		}
+	}
+	s.creaturePool = append(s.creaturePool, c)
+	s.creatures, s.creatures[len(s.creatures)-1] =
+		append(s.creatures[:i], s.creatures[i+1:]...), nil
+	s.notifyDeath(c, d)
+	return d
+}
+
+// sortHallOfFame sorts the top creatures by score and removes the excess
+func (s *Sim) sortHallOfFame() {
+	// sort top creatures
+	sort.Sort(sort.Reverse(s.bestCreatures))
+	// remove excess top creatures
+	n := s.cfg.hallOfFameSize(len(s.bestCreatures))
+	for i := len(s.bestCreatures) - 1; i >= n; i-- {
+		s.bestCreatures[i] = nil
+		s.bestCreatures = s.bestCreatures[:len(s.bestCreatures)-1]
+	}
+}
+
+// DoTick runs the simulation by a single tick and then draws the new state
+// with the Sim's Renderer
 func (s *Sim) DoTick() {
-	// draw the sim border
-	s.gc.SetFillColor(Black)
-	// top
-	draw2dkit.Rectangle(s.gc, 0, 0, s.frameWidthf, s.borderWidthf)
-	s.gc.Fill()
-	// left
-	draw2dkit.Rectangle(s.gc, 0, s.borderWidthf, s.borderWidthf, s.frameHeightf)
-	s.gc.Fill()
-	// right
-	draw2dkit.Rectangle(s.gc, s.frameWidthf-s.borderWidthf, s.borderWidthf,
-		s.frameWidthf, s.frameHeightf)
-	s.gc.Fill()
-	// bottom
-	draw2dkit.Rectangle(s.gc, s.borderWidthf, s.frameHeightf-s.borderWidthf,
-		s.frameWidthf-s.borderWidthf, s.frameHeightf)
-	s.gc.Fill()
-
-	// clear actual drawing area to BG color
-	draw2dkit.Rectangle(s.gc, s.borderWidthf, s.borderWidthf,
-		s.frameWidthf-s.borderWidthf, s.frameHeightf-s.borderWidthf)
-	s.gc.SetFillColor(BGColor)
-	s.gc.Fill()
+	s.deaths = s.deaths[:0]
+	// restart at the end of each episode
+	if s.cfg.EpisodeTicks > 0 && s.tickCounter > 0 && s.tickCounter%s.cfg.EpisodeTicks == 0 {
+		s.endEpisode()
+	}
 
 	// update Obstacles
 	for i := 0; i < len(s.obstacles); i++ {
-		s.obstacles[i].x += s.obstacles[i].dx
-		s.obstacles[i].y += s.obstacles[i].dy
+		s.obstacles[i].step(s)
 		// remove obstacles far off screen
 		x := s.obstacles[i].x
 		y := s.obstacles[i].y
@@ -396,40 +701,34 @@ func (s *Sim) DoTick() {
 			i--
 		}
 	}
-	if len(s.obstacles) < numObstacles {
-		s.SpawnObstacles(numObstacles - len(s.obstacles))
-	}
+	s.spawnObstacles()
 
-	// draw Obstacles
-	s.gc.SetFillColor(color.Black)
-	s.gc.SetLineWidth(obstacleWidth)
-	for i := 0; i < len(s.obstacles); i++ {
-		x := s.obstacles[i].x
-		y := s.obstacles[i].y
-		l := s.obstacles[i].length
-		a := s.obstacles[i].angle
-		s.gc.MoveTo(s.borderWidthf+x, s.borderWidthf+y)
-		s.gc.LineTo(s.borderWidthf+x+math.Cos(a)*l, s.borderWidthf+y+math.Sin(a)*l)
-		s.gc.FillStroke()
-		s.gc.Close()
-	}
+	// draw the border and obstacles to the collision buffer
+	s.updateState()
+	drawBorder(s.gc, &s.state)
+	drawObstacles(s.gc, &s.state)
+	drawWalls(s.gc, &s.state)
 
 	// handle evolution cycle
-	if s.tickCounter%evolutionCycleTicks == 0 {
+	if s.tickCounter%s.cfg.EvolutionCycleTicks == 0 {
+		s.updateLevel()
+		s.notifyEvolutionCycle(s.tickCounter / s.cfg.EvolutionCycleTicks)
 		// spawn new creatures if we aren't already overpopulated
-		if len(s.creatures) < maxCreatures {
-			s.SpawnCreatures(maxCreatures - len(s.creatures))
+		if len(s.creatures) < s.cfg.MaxCreatures {
+			s.SpawnCreatures(s.cfg.MaxCreatures - len(s.creatures))
 		}
 	}
 
 	// spawn new creatures if we have less than minimum
-	if len(s.creatures) < minCreatures {
-		s.SpawnCreatures(minCreatures - len(s.creatures))
+	if len(s.creatures) < s.cfg.MinCreatures {
+		s.SpawnCreatures(s.cfg.MinCreatures - len(s.creatures))
 	}
 	// randomize creature order
 	s.shuffleCreatures()
 
 	// first remove "dead" creatures
+	creatureRadius := s.cfg.CreatureRadius
+	creatureRadiusf := float64(creatureRadius)
 	for i := 0; i < len(s.creatures); i++ {
 		// determine bounding box
 		x := s.borderWidthf + s.creatures[i].x
@@ -439,32 +738,24 @@ func (s *Sim) DoTick() {
 		right := int(x) + creatureRadius
 		bottom := int(y) + creatureRadius
 		dead := false
+		cause := DeathByObstacle
 		for cy := top; cy <= bottom && !dead; cy++ {
 			for cx := left; cx <= right && !dead; cx++ {
 				if xyDist(x, y, float64(cx), float64(cy)) <= creatureRadiusf {
-					if s.CurrentFrame.At(cx, cy) != BGColor {
+					if s.frame.At(cx, cy) != BGColor {
 						dead = true
+						if s.inBorder(cx, cy) {
+							cause = DeathByBorder
+						} else {
+							cause = s.collisionCause(s.creatures[i].x, s.creatures[i].y)
+						}
 					}
 				}
 			}
 		}
-		// if dead, remove
+		// if dead, record the death and remove
 		if dead {
-			weights := s.creatures[i].brain.GetWeights()
-			index := s.bestCreatures.IndexOfWeights(weights)
-			if index == -1 {
-				s.bestCreatures = append(s.bestCreatures, &TopCreature{
-					weights: s.creatures[i].brain.GetWeights(),
-					score:   s.creatures[i].score,
-				})
-			} else {
-				if s.bestCreatures[index].score < s.creatures[i].score {
-					s.bestCreatures[index].score = s.creatures[i].score
-				}
-			}
-			s.creaturePool = append(s.creaturePool, s.creatures[i])
-			s.creatures, s.creatures[len(s.creatures)-1] =
-				append(s.creatures[:i], s.creatures[i+1:]...), nil
+			s.deaths = append(s.deaths, s.killCreature(i, cause))
 			i--
 		}
 	}
@@ -473,11 +764,11 @@ func (s *Sim) DoTick() {
 	for i := range s.creatures {
 		turn, move := s.creatures[i].GetAction(s)
 		//move = (move + 1) / float64(2)
-		s.creatures[i].angle += turn / 8
+		s.creatures[i].angle += turn * s.cfg.TurnRate
 		ax := math.Cos(s.creatures[i].angle)
 		ay := math.Sin(s.creatures[i].angle)
-		s.creatures[i].x += ax * move * 4 /*+ ax*math.Copysign(turn/2, move)*/
-		s.creatures[i].y += ay * move * 4 /*+ ay*math.Copysign(turn/2, move)*/
+		s.creatures[i].x += ax * move * s.cfg.MoveSpeed /*+ ax*math.Copysign(turn/2, move)*/
+		s.creatures[i].y += ay * move * s.cfg.MoveSpeed /*+ ay*math.Copysign(turn/2, move)*/
 		// increment the score unless somehow we've reached the maximum score
 		if s.creatures[i].score < math.MaxInt64 {
 			s.creatures[i].score++
@@ -492,6 +783,7 @@ func (s *Sim) DoTick() {
 			s.bestCreatures = append(s.bestCreatures, &TopCreature{
 				weights: s.creatures[i].brain.GetWeights(),
 				score:   s.creatures[i].score,
+				lineage: s.creatures[i].lineage,
 			})
 		} else {
 			if s.bestCreatures[index].score < s.creatures[i].score {
@@ -499,30 +791,20 @@ func (s *Sim) DoTick() {
 			}
 		}
 	}
-	// sort top creatures
-	sort.Sort(sort.Reverse(s.bestCreatures))
-	// remove excess top creatures
-	if len(s.bestCreatures) > maxBestCreatures {
-		for i := len(s.bestCreatures) - 1; i > maxCreatures; i-- {
-			s.bestCreatures[i] = nil
-			s.bestCreatures = s.bestCreatures[:len(s.bestCreatures)-1]
-		}
-	}
+	s.sortHallOfFame()
 
-	// draw creatures
-	for i := range s.creatures {
-		s.gc.SetFillColor(s.creatures[i].color)
-		draw2dkit.Circle(s.gc, s.borderWidthf+s.creatures[i].x,
-			s.borderWidthf+s.creatures[i].y, creatureRadius)
-		s.gc.Fill()
-		ax := math.Cos(s.creatures[i].angle)
-		ay := math.Sin(s.creatures[i].angle)
-		s.gc.SetFillColor(color.White)
-		draw2dkit.Circle(s.gc, s.borderWidthf+s.creatures[i].x+ax*3,
-			s.borderWidthf+s.creatures[i].y+ay*3, 2)
-		s.gc.Fill()
+	// tell observers if we have a new best creature
+	if len(s.bestCreatures) > 0 && s.bestCreatures[0] != s.bestCreature {
+		s.bestCreature = s.bestCreatures[0]
+		s.notifyHallOfFameUpdate(s.bestCreature)
 	}
 
 	// increment tick count
 	s.tickCounter++
+
+	// draw the new state
+	s.updateState()
+	s.renderer.Render(&s.state)
+
+	s.notifyTick()
 }
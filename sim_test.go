@@ -0,0 +1,51 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+)
+
+func TestStateCopiesBrains(t *testing.T) {
+	s := NewSim(DefaultConfig(), 1)
+	for i := 0; i < 3; i++ {
+		s.DoTick()
+	}
+	w := s.State()
+	if len(w.Creatures) != len(s.creatures) || len(w.Creatures) == 0 {
+		t.Fatalf("state has %d creatures, want %d", len(w.Creatures), len(s.creatures))
+	}
+	for i, c := range s.creatures {
+		cs := &w.Creatures[i]
+		for _, pair := range []struct {
+			name       string
+			state, sim []float64
+		}{
+			{"sensors", cs.Sensors, c.sensors},
+			{"memory", cs.Memory, c.brain.Memory()},
+			{"weights", cs.Weights, c.brain.GetWeights()},
+		} {
+			if len(pair.state) != len(pair.sim) || len(pair.sim) == 0 {
+				t.Fatalf("creature %d has %d %s in the state, want %d",
+					c.id, len(pair.state), pair.name, len(pair.sim))
+			}
+			if &pair.state[0] == &pair.sim[0] {
+				t.Errorf("creature %d shares its %s with the state", c.id, pair.name)
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// stateFileName is the name of the state file in the app's files directory
+const stateFileName = "state.json"
+
+// filesDir returns the app's private files directory on android, which is
+// next to the cache directory gomobile sets TMPDIR to, or "" elsewhere
+func filesDir() string {
+	if !onAndroid {
+		return ""
+	}
+	tmp := os.Getenv("TMPDIR")
+	if tmp == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(tmp), "files")
+}
+
+// statePath returns the file the app state is saved to when the app stops,
+// or "" if the state is not saved
+func statePath() string {
+	if *statePathFlag != "" {
+		return *statePathFlag
+	}
+	if dir := filesDir(); dir != "" {
+		return filepath.Join(dir, stateFileName)
+	}
+	return ""
+}
+
+// SaveState saves the simulation's hall of fame to the state file so that
+// the evolved genomes survive the app being killed. The state is saved in
+// the hall of fame format, replacing the previous state.
+// WARNING: SaveState must not be called while the SimRunner is running
+func SaveState() {
+	path := statePath()
+	if path == "" || player != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		log.Printf("failed to save state: %v", err)
+		return
+	}
+	h := &HallOfFame{Version: hallOfFameVersion}
+	for _, g := range sim.HallOfFame() {
+		h.Add(g)
+	}
+	if err := h.Save(path); err != nil {
+		log.Printf("failed to save state: %v", err)
+		return
+	}
+	log.Printf("saved %d genomes to %s", len(h.Genomes), path)
+}
+
+// RestoreState loads the hall of fame saved by SaveState into the
+// simulation, if there is one
+func RestoreState() {
+	path := statePath()
+	if path == "" || player != nil {
+		return
+	}
+	h, err := LoadHallOfFame(path)
+	if err != nil {
+		log.Printf("failed to restore state: %v", err)
+		return
+	}
+	if len(h.Genomes) == 0 {
+		return
+	}
+	sim.ApplyInput(Input{Kind: InputLoadHallOfFame, Genomes: h.Genomes})
+	log.Printf("restored %d genomes from %s", len(h.Genomes), path)
+}
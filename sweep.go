@@ -0,0 +1,317 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// sweepParam is a Config field varied by a sweep, named by its flag, either
+// over a list of values or uniformly over the range [min, max]
+type sweepParam struct {
+	name     string
+	values   []float64
+	min, max float64
+}
+
+// parseSweepParams parses a list of parameters separated by semicolons, each
+// either name=v1,v2,... for a grid of values or name=min:max for a range
+func parseSweepParams(spec string) ([]sweepParam, error) {
+	var params []sweepParam
+	for _, item := range strings.Split(spec, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		eq := strings.Index(item, "=")
+		if eq < 0 {
+			return nil, fmt.Errorf("sweep: expected name=values in %q", item)
+		}
+		p := sweepParam{name: strings.TrimSpace(item[:eq])}
+		switch configField(&Config{}, p.name).(type) {
+		case *int, *float64:
+		case nil:
+			return nil, fmt.Errorf("sweep: unknown parameter %q", p.name)
+		default:
+			return nil, fmt.Errorf("sweep: parameter %q is not a number", p.name)
+		}
+		values := item[eq+1:]
+		if r := strings.Split(values, ":"); len(r) == 2 {
+			var err1, err2 error
+			p.min, err1 = strconv.ParseFloat(strings.TrimSpace(r[0]), 64)
+			p.max, err2 = strconv.ParseFloat(strings.TrimSpace(r[1]), 64)
+			if err1 != nil || err2 != nil || p.max < p.min {
+				return nil, fmt.Errorf("sweep: invalid range for %s", p.name)
+			}
+		} else {
+			for _, v := range strings.Split(values, ",") {
+				f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+				if err != nil {
+					return nil, fmt.Errorf("sweep: invalid value for %s: %v", p.name, err)
+				}
+				p.values = append(p.values, f)
+			}
+		}
+		params = append(params, p)
+	}
+	if len(params) == 0 {
+		return nil, errors.New("sweep: no parameters to sweep")
+	}
+	return params, nil
+}
+
+// configField returns a pointer to the field of c for the flag name, or nil
+func configField(c *Config, name string) interface{} {
+	for _, f := range configFlags {
+		if f.name == name {
+			return f.field(c)
+		}
+	}
+	return nil
+}
+
+// setConfigField sets the field of c for the flag name to v, rounding v
+// for integer fields
+func setConfigField(c *Config, name string, v float64) {
+	switch p := configField(c, name).(type) {
+	case *int:
+		*p = int(math.Floor(v + 0.5))
+	case *float64:
+		*p = v
+	}
+}
+
+// sweepPoints returns the parameter values of each point of the sweep. If
+// every parameter is a grid the points are every combination of values,
+// otherwise there are samples points with ranges drawn uniformly and grid
+// values picked at random using rng.
+func sweepPoints(params []sweepParam, samples int, rng *rand.Rand) [][]float64 {
+	random := false
+	for _, p := range params {
+		random = random || p.values == nil
+	}
+	var points [][]float64
+	if random {
+		for i := 0; i < samples; i++ {
+			point := make([]float64, len(params))
+			for j, p := range params {
+				if p.values != nil {
+					point[j] = p.values[rng.Intn(len(p.values))]
+				} else {
+					point[j] = p.min + rng.Float64()*(p.max-p.min)
+				}
+			}
+			points = append(points, point)
+		}
+		return points
+	}
+	points = [][]float64{{}}
+	for _, p := range params {
+		var next [][]float64
+		for _, point := range points {
+			for _, v := range p.values {
+				next = append(next, append(append([]float64{}, point...), v))
+			}
+		}
+		points = next
+	}
+	return points
+}
+
+// sweepRun is a single headless run of a sweep
+type sweepRun struct {
+	point, replicate int
+	seed             int64
+	cfg              Config
+	// results
+	err       error
+	bestScore int64   // the best score in the hall of fame
+	meanScore float64 // the mean score in the hall of fame
+	bestAlive int64   // the best score of the live creatures
+	// the first tick the hall of fame reached the threshold, or -1
+	thresholdTick int
+	deaths        int64
+	level         int // the final difficulty level of the curriculum
+}
+
+// thresholdObserver records the first tick the best score in the hall of
+// fame reaches threshold
+type thresholdObserver struct {
+	BaseObserver
+	threshold int64
+	tick      int
+}
+
+// OnHallOfFameUpdate implements Observer
+func (o *thresholdObserver) OnHallOfFameUpdate(s *Sim, best *TopCreature) {
+	if o.tick < 0 && best.score >= o.threshold {
+		o.tick = s.tickCounter
+	}
+}
+
+// OnTick implements Observer, as the best creature's score also grows
+// without it changing
+func (o *thresholdObserver) OnTick(s *Sim) {
+	if o.tick < 0 && len(s.bestCreatures) > 0 && s.bestCreatures[0].score >= o.threshold {
+		o.tick = s.tickCounter
+	}
+}
+
+// run runs the simulation for ticks ticks and records the results
+func (r *sweepRun) run(ticks int, threshold int64) {
+	if r.err = r.cfg.Validate(); r.err != nil {
+		return
+	}
+	s := NewSim(r.cfg, r.seed)
+	o := &thresholdObserver{threshold: threshold, tick: -1}
+	s.AddObserver(o)
+	for i := 0; i < ticks; i++ {
+		s.DoTick()
+	}
+	r.thresholdTick = o.tick
+	if len(s.bestCreatures) > 0 {
+		r.bestScore = s.bestCreatures[0].score
+		var total int64
+		for _, t := range s.bestCreatures {
+			total += t.score
+		}
+		r.meanScore = float64(total) / float64(len(s.bestCreatures))
+	}
+	for _, c := range s.creatures {
+		if c.score > r.bestAlive {
+			r.bestAlive = c.score
+		}
+	}
+	for _, n := range s.deathCounts {
+		r.deaths += n
+	}
+	r.level = s.Level()
+}
+
+// RunSweep runs the sweep command with the arguments args, varying the
+// base configuration cfg
+func RunSweep(cfg Config, args []string) error {
+	fs := flag.NewFlagSet("sweep", flag.ExitOnError)
+	spec := fs.String("params", "", `parameters to sweep by flag name, e.g. "obstacles=2,6,10;mutation-rate=0:0.1", lists of values are swept as a grid and min:max ranges are sampled`)
+	samples := fs.Int("samples", 10, "number of points to sample when sweeping ranges")
+	replicates := fs.Int("replicates", 3, "number of runs with different seeds per point")
+	ticks := fs.Int("ticks", 20000, "number of ticks to run each replicate")
+	threshold := fs.Int64("threshold", 1000, "best score to record the ticks to reach")
+	seed := fs.Int64("seed", 1, "seed of the first replicate of each point, and for sampling points")
+	parallel := fs.Int("parallel", runtime.NumCPU(), "number of runs at once")
+	out := fs.String("out", "", "write the results as CSV to this file instead of stdout")
+	fs.Parse(args)
+	params, err := parseSweepParams(*spec)
+	if err != nil {
+		return err
+	}
+	if *replicates < 1 || *ticks < 1 || *parallel < 1 {
+		return errors.New("sweep: -replicates, -ticks and -parallel must be at least 1")
+	}
+	points := sweepPoints(params, *samples, rand.New(rand.NewSource(*seed)))
+	var runs []*sweepRun
+	for i, point := range points {
+		c := cfg
+		for j, p := range params {
+			setConfigField(&c, p.name, point[j])
+		}
+		for r := 0; r < *replicates; r++ {
+			runs = append(runs, &sweepRun{
+				point:     i,
+				replicate: r,
+				seed:      *seed + int64(r),
+				cfg:       c,
+			})
+		}
+	}
+	log.Printf("sweep: %d points, %d runs of %d ticks", len(points), len(runs), *ticks)
+	// each Sim is independent, so runs can share nothing but the queue
+	queue := make(chan *sweepRun)
+	var wg sync.WaitGroup
+	for i := 0; i < *parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range queue {
+				r.run(*ticks, *threshold)
+			}
+		}()
+	}
+	for _, r := range runs {
+		queue <- r
+	}
+	close(queue)
+	wg.Wait()
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return writeSweepResults(w, params, runs)
+}
+
+// writeSweepResults writes a CSV table with a row for each run
+func writeSweepResults(w io.Writer, params []sweepParam, runs []*sweepRun) error {
+	cw := csv.NewWriter(w)
+	header := []string{"point", "replicate", "seed"}
+	for _, p := range params {
+		header = append(header, p.name)
+	}
+	header = append(header, "best_score", "mean_score", "best_alive",
+		"ticks_to_threshold", "deaths", "level", "error")
+	cw.Write(header)
+	for _, r := range runs {
+		row := []string{strconv.Itoa(r.point), strconv.Itoa(r.replicate),
+			strconv.FormatInt(r.seed, 10)}
+		for _, p := range params {
+			switch v := configField(&r.cfg, p.name).(type) {
+			case *int:
+				row = append(row, strconv.Itoa(*v))
+			case *float64:
+				row = append(row, strconv.FormatFloat(*v, 'g', -1, 64))
+			}
+		}
+		if r.err != nil {
+			// the run was skipped, so it has no results
+			row = append(row, "", "", "", "", "", "", r.err.Error())
+		} else {
+			row = append(row, strconv.FormatInt(r.bestScore, 10),
+				strconv.FormatFloat(r.meanScore, 'f', 1, 64),
+				strconv.FormatInt(r.bestAlive, 10), strconv.Itoa(r.thresholdTick),
+				strconv.FormatInt(r.deaths, 10), strconv.Itoa(r.level), "")
+		}
+		cw.Write(row)
+	}
+	cw.Flush()
+	return cw.Error()
+}
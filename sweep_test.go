@@ -0,0 +1,133 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSweepParams(t *testing.T) {
+	tests := []struct {
+		spec   string
+		params []sweepParam
+		err    string // a substring of the error, or "" for none
+	}{
+		{"obstacles=2,6,10", []sweepParam{{name: "obstacles", values: []float64{2, 6, 10}}}, ""},
+		{"mutation-rate=0:0.1", []sweepParam{{name: "mutation-rate", min: 0, max: 0.1}}, ""},
+		{" obstacles = 4 ; move-speed=1:2;", []sweepParam{
+			{name: "obstacles", values: []float64{4}},
+			{name: "move-speed", min: 1, max: 2},
+		}, ""},
+		{"turn-rate=0.5:0.5", []sweepParam{{name: "turn-rate", min: 0.5, max: 0.5}}, ""},
+		{"", nil, "no parameters"},
+		{" ; ", nil, "no parameters"},
+		{"obstacles", nil, `expected name=values in "obstacles"`},
+		{"bogus=1", nil, `unknown parameter "bogus"`},
+		{"obstacle-kinds=1", nil, `parameter "obstacle-kinds" is not a number`},
+		{"obstacles=1,x", nil, "invalid value for obstacles"},
+		{"obstacles=1,,2", nil, "invalid value for obstacles"},
+		{"mutation-rate=0.2:0.1", nil, "invalid range for mutation-rate"},
+		{"mutation-rate=a:1", nil, "invalid range for mutation-rate"},
+		{"mutation-rate=0:1:2", nil, "invalid value for mutation-rate"},
+	}
+	for _, test := range tests {
+		params, err := parseSweepParams(test.spec)
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("parseSweepParams(%q): unexpected error: %v", test.spec, err)
+		case test.err != "" && err == nil:
+			t.Errorf("parseSweepParams(%q): expected an error containing %q", test.spec, test.err)
+		case test.err != "" && !strings.Contains(err.Error(), test.err):
+			t.Errorf("parseSweepParams(%q): error %q does not contain %q", test.spec, err, test.err)
+		case test.err == "" && !reflect.DeepEqual(params, test.params):
+			t.Errorf("parseSweepParams(%q) = %+v, want %+v", test.spec, params, test.params)
+		}
+	}
+}
+
+func TestSweepPointsGrid(t *testing.T) {
+	params := []sweepParam{
+		{name: "obstacles", values: []float64{2, 4}},
+		{name: "mutation-rate", values: []float64{0, 0.1, 0.2}},
+		{name: "move-speed", values: []float64{3}},
+	}
+	// every combination, varying the last parameter fastest
+	want := [][]float64{
+		{2, 0, 3}, {2, 0.1, 3}, {2, 0.2, 3},
+		{4, 0, 3}, {4, 0.1, 3}, {4, 0.2, 3},
+	}
+	// grids ignore the number of samples and the rng
+	if got := sweepPoints(params, 100, nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("sweepPoints() = %v, want %v", got, want)
+	}
+}
+
+func TestSweepPointsRandom(t *testing.T) {
+	params := []sweepParam{
+		{name: "obstacles", values: []float64{2, 4}},
+		{name: "mutation-rate", min: 0.1, max: 0.2},
+	}
+	points := sweepPoints(params, 50, rand.New(rand.NewSource(1)))
+	if len(points) != 50 {
+		t.Fatalf("sweepPoints() returned %d points, want 50", len(points))
+	}
+	seen := map[float64]bool{}
+	for _, p := range points {
+		if len(p) != 2 || (p[0] != 2 && p[0] != 4) || p[1] < 0.1 || p[1] > 0.2 {
+			t.Fatalf("point %v outside the parameters", p)
+		}
+		seen[p[0]] = true
+	}
+	if !seen[2] || !seen[4] {
+		t.Errorf("sampled grid values %v, want both 2 and 4", seen)
+	}
+	// the same seed samples the same points
+	if again := sweepPoints(params, 50, rand.New(rand.NewSource(1))); !reflect.DeepEqual(again, points) {
+		t.Error("sweepPoints() is not deterministic for a seed")
+	}
+}
+
+func TestWriteSweepResults(t *testing.T) {
+	params := []sweepParam{
+		{name: "obstacles", values: []float64{2}},
+		{name: "mutation-rate", min: 0, max: 1},
+	}
+	cfg := DefaultConfig()
+	cfg.NumObstacles = 2
+	cfg.MutationRate = 0.25
+	runs := []*sweepRun{
+		{point: 0, replicate: 0, seed: 1, cfg: cfg, bestScore: 120, meanScore: 80.25,
+			bestAlive: 30, thresholdTick: -1, deaths: 7, level: 2},
+		{point: 0, replicate: 1, seed: 2, cfg: cfg, err: errors.New("config: bad")},
+	}
+	var buf bytes.Buffer
+	if err := writeSweepResults(&buf, params, runs); err != nil {
+		t.Fatal(err)
+	}
+	want := "point,replicate,seed,obstacles,mutation-rate,best_score,mean_score," +
+		"best_alive,ticks_to_threshold,deaths,level,error\n" +
+		"0,0,1,2,0.25,120,80.2,30,-1,7,2,\n" +
+		"0,1,2,2,0.25,,,,,,,config: bad\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got csv\n%s\nwant\n%s", got, want)
+	}
+}
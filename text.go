@@ -0,0 +1,71 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+//go:generate go run genfont.go
+
+import (
+	"log"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/llgcode/draw2d"
+	"golang.org/x/image/math/fixed"
+)
+
+// textFontData names the font used for text drawn over the arena, which is
+// registered with draw2d from the embedded luxiMonoTTF rather than loaded
+// from draw2d's font folder, which is not available on Android
+var textFontData = draw2d.FontData{
+	Name:   "luxi",
+	Family: draw2d.FontFamilyMono,
+	Style:  draw2d.FontStyleNormal,
+}
+
+// textFont is the parsed textFontData font
+var textFont *truetype.Font
+
+// textSize is the height of the font's em square in frame pixels, chosen so
+// that every glyph fits in textLineHeight
+const textSize = 10.5
+
+// textLineHeight is the height of a line of text in frame pixels
+const textLineHeight = 13
+
+func init() {
+	var err error
+	textFont, err = truetype.Parse([]byte(luxiMonoTTF))
+	if err != nil {
+		log.Fatalf("text: parsing the embedded font: %v", err)
+	}
+	draw2d.RegisterFont(textFontData, textFont)
+}
+
+// textWidth returns the width of text drawn with fillText
+func textWidth(text string) float64 {
+	return fontTextWidth(textFont, textSize, text)
+}
+
+// fillText fills text in the current fill color with the top left corner of
+// the line at x, y and returns the width of the text
+func fillText(gc draw2d.GraphicContext, text string, x, y float64) float64 {
+	gc.SetFontData(textFontData)
+	// the font size is in points, textSize is in pixels
+	gc.SetFontSize(textSize * 72 / float64(gc.GetDPI()))
+	// the tallest glyph touches the top of the line
+	ascent := float64(textFont.Bounds(fixed.Int26_6(textSize*64)).Max.Y) / 64
+	return gc.FillStringAt(text, x, y+ascent)
+}
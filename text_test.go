@@ -0,0 +1,51 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/llgcode/draw2d/draw2dimg"
+)
+
+// TestFillText checks that text is drawn within its line and as wide as
+// textWidth reports
+func TestFillText(t *testing.T) {
+	const text = "Ågjy|{} 0123"
+	img := image.NewRGBA(image.Rect(0, 0, 200, 3*textLineHeight))
+	gc := draw2dimg.NewGraphicContext(img)
+	gc.SetFillColor(color.White)
+	width := fillText(gc, text, 10, textLineHeight)
+	if want := textWidth(text); math.Abs(width-want) > 0.1 {
+		t.Errorf("fillText() = %v, textWidth() = %v", width, want)
+	}
+	drawn := image.Rectangle{}
+	for y := 0; y < img.Bounds().Dy(); y++ {
+		for x := 0; x < img.Bounds().Dx(); x++ {
+			if img.RGBAAt(x, y).A != 0 {
+				drawn = drawn.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	line := image.Rect(10, textLineHeight, 10+int(math.Ceil(width)), 2*textLineHeight)
+	if drawn.Empty() || !drawn.In(line) {
+		t.Errorf("text drawn in %v, want within %v", drawn, line)
+	}
+}
@@ -0,0 +1,362 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/jung-kurt/gofpdf"
+	"github.com/llgcode/draw2d"
+	"github.com/llgcode/draw2d/draw2dbase"
+	"github.com/llgcode/draw2d/draw2dpdf"
+	"golang.org/x/image/math/fixed"
+)
+
+// vectorFlatness is the scale passed to draw2dbase.Flatten, larger values
+// approximate curves with more segments
+const vectorFlatness = 8
+
+// polyline is a flattened sub path in output coordinates
+type polyline struct {
+	points []float64
+	closed bool
+}
+
+// polylineBuilder implements draw2dbase.Flattener by collecting polylines
+type polylineBuilder struct {
+	lines   []polyline
+	current *polyline
+}
+
+func (b *polylineBuilder) MoveTo(x, y float64) {
+	b.lines = append(b.lines, polyline{points: []float64{x, y}})
+	b.current = &b.lines[len(b.lines)-1]
+}
+
+func (b *polylineBuilder) LineTo(x, y float64) {
+	// draw2d allows lines without a preceding move, which start a sub path
+	if b.current == nil {
+		b.MoveTo(x, y)
+		return
+	}
+	b.current.points = append(b.current.points, x, y)
+}
+
+func (b *polylineBuilder) LineJoin() {}
+
+func (b *polylineBuilder) Close() {
+	if b.current != nil {
+		b.current.closed = true
+		b.current = nil
+	}
+}
+
+func (b *polylineBuilder) End() {
+	b.current = nil
+}
+
+// vectorPainter receives the flattened paths painted on a
+// vectorGraphicContext and writes them in a vector format
+type vectorPainter interface {
+	// paint draws the polylines, filling them with fill and stroking them
+	// with stroke if they are not nil
+	paint(lines []polyline, fill, stroke color.Color, rule draw2d.FillRule,
+		lineWidth float64)
+	// text draws s with the left of its baseline at x, y in a font of the
+	// family and size, filling and stroking it like paint
+	text(s string, x, y, size float64, family draw2d.FontFamily,
+		fill, stroke color.Color, lineWidth float64)
+}
+
+// vectorGraphicContext implements draw2d.GraphicContext for vector output
+// that draw2d has no backend for, which is svg at the vendored revision.
+// Paths are flattened to polylines in output coordinates and handed to a
+// vectorPainter. Text is handed over as strings in the family of the current
+// font rather than as glyph outlines, so CreateStringPath adds nothing to the
+// path. Images are not supported and are not drawn.
+type vectorGraphicContext struct {
+	*draw2dbase.StackGraphicContext
+	painter vectorPainter
+	dpi     int
+}
+
+func newVectorGraphicContext(painter vectorPainter) *vectorGraphicContext {
+	return &vectorGraphicContext{
+		StackGraphicContext: draw2dbase.NewStackGraphicContext(),
+		painter:             painter,
+		dpi:                 72,
+	}
+}
+
+// flatten converts the current path and paths to polylines and clears
+// the current path
+func (gc *vectorGraphicContext) flatten(paths []*draw2d.Path) []polyline {
+	paths = append(paths, gc.Current.Path)
+	b := &polylineBuilder{}
+	t := draw2dbase.Transformer{Tr: gc.Current.Tr, Flattener: b}
+	for _, p := range paths {
+		draw2dbase.Flatten(p, t, gc.Current.Tr.GetScale()*vectorFlatness)
+	}
+	gc.Current.Path.Clear()
+	// drop degenerate sub paths
+	lines := b.lines[:0]
+	for _, l := range b.lines {
+		if len(l.points) >= 4 {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+func (gc *vectorGraphicContext) Stroke(paths ...*draw2d.Path) {
+	gc.painter.paint(gc.flatten(paths), nil, gc.Current.StrokeColor,
+		gc.Current.FillRule, gc.Current.LineWidth*gc.Current.Tr.GetScale())
+}
+
+func (gc *vectorGraphicContext) Fill(paths ...*draw2d.Path) {
+	gc.painter.paint(gc.flatten(paths), gc.Current.FillColor, nil,
+		gc.Current.FillRule, 0)
+}
+
+func (gc *vectorGraphicContext) FillStroke(paths ...*draw2d.Path) {
+	gc.painter.paint(gc.flatten(paths), gc.Current.FillColor,
+		gc.Current.StrokeColor, gc.Current.FillRule,
+		gc.Current.LineWidth*gc.Current.Tr.GetScale())
+}
+
+func (gc *vectorGraphicContext) Clear()                       {}
+func (gc *vectorGraphicContext) ClearRect(x1, y1, x2, y2 int) {}
+func (gc *vectorGraphicContext) DrawImage(img image.Image)    {}
+func (gc *vectorGraphicContext) SetDPI(dpi int)               { gc.dpi = dpi }
+func (gc *vectorGraphicContext) GetDPI() int                  { return gc.dpi }
+
+// font returns the current font, or nil if draw2d can not load it
+func (gc *vectorGraphicContext) font() *truetype.Font {
+	return draw2d.GetFont(gc.Current.FontData)
+}
+
+// fontPixels returns the size of the current font in user units
+func (gc *vectorGraphicContext) fontPixels() float64 {
+	// the font size is in points
+	return gc.Current.FontSize * float64(gc.dpi) / 72
+}
+
+// drawString hands text at x, y to the painter and returns its width
+func (gc *vectorGraphicContext) drawString(text string, x, y float64,
+	fill, stroke color.Color) float64 {
+	scale := gc.Current.Tr.GetScale()
+	tx, ty := gc.Current.Tr.TransformPoint(x, y)
+	gc.painter.text(text, tx, ty, gc.fontPixels()*scale,
+		gc.Current.FontData.Family, fill, stroke, gc.Current.LineWidth*scale)
+	_, _, right, _ := gc.GetStringBounds(text)
+	return right
+}
+
+// fontTextWidth returns the width of text in the font f with an em square
+// size pixels high
+func fontTextWidth(f *truetype.Font, size float64, text string) float64 {
+	scale := fixed.Int26_6(size * 64)
+	var width fixed.Int26_6
+	prev, hasPrev := truetype.Index(0), false
+	for _, r := range text {
+		index := f.Index(r)
+		if hasPrev {
+			width += f.Kern(scale, prev, index)
+		}
+		width += f.HMetric(scale, index).AdvanceWidth
+		prev, hasPrev = index, true
+	}
+	return float64(width) / 64
+}
+
+func (gc *vectorGraphicContext) GetStringBounds(s string) (left, top, right, bottom float64) {
+	f := gc.font()
+	if f == nil {
+		return 0, 0, 0, 0
+	}
+	size := gc.fontPixels()
+	b := f.Bounds(fixed.Int26_6(size * 64))
+	return 0, -float64(b.Max.Y) / 64, fontTextWidth(f, size, s), -float64(b.Min.Y) / 64
+}
+
+func (gc *vectorGraphicContext) CreateStringPath(text string, x, y float64) float64 {
+	_, _, right, _ := gc.GetStringBounds(text)
+	return right
+}
+
+func (gc *vectorGraphicContext) FillString(text string) float64 {
+	return gc.FillStringAt(text, 0, 0)
+}
+
+func (gc *vectorGraphicContext) FillStringAt(text string, x, y float64) float64 {
+	return gc.drawString(text, x, y, gc.Current.FillColor, nil)
+}
+
+func (gc *vectorGraphicContext) StrokeString(text string) float64 {
+	return gc.StrokeStringAt(text, 0, 0)
+}
+
+func (gc *vectorGraphicContext) StrokeStringAt(text string, x, y float64) float64 {
+	return gc.drawString(text, x, y, nil, gc.Current.StrokeColor)
+}
+
+// rgb8 returns the 8 bit per channel red, green, and blue of c
+func rgb8(c color.Color) (r, g, b uint8) {
+	rgba := color.RGBAModel.Convert(c).(color.RGBA)
+	return rgba.R, rgba.G, rgba.B
+}
+
+// svgPainter writes paths as svg path elements
+type svgPainter struct {
+	w *bufio.Writer
+}
+
+func (p *svgPainter) paint(lines []polyline, fill, stroke color.Color,
+	rule draw2d.FillRule, lineWidth float64) {
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Fprint(p.w, `<path d="`)
+	for _, l := range lines {
+		for i := 0; i < len(l.points); i += 2 {
+			cmd := "L"
+			if i == 0 {
+				cmd = "M"
+			}
+			fmt.Fprintf(p.w, "%s%.3f %.3f ", cmd, l.points[i], l.points[i+1])
+		}
+		if l.closed {
+			fmt.Fprint(p.w, "Z ")
+		}
+	}
+	fmt.Fprint(p.w, `"`)
+	p.paintAttrs(fill, stroke, rule, lineWidth)
+	fmt.Fprint(p.w, "/>\n")
+}
+
+// paintAttrs writes the attributes filling and stroking an element
+func (p *svgPainter) paintAttrs(fill, stroke color.Color, rule draw2d.FillRule,
+	lineWidth float64) {
+	if fill != nil {
+		r, g, b := rgb8(fill)
+		fmt.Fprintf(p.w, ` fill="#%02x%02x%02x"`, r, g, b)
+		if rule == draw2d.FillRuleEvenOdd {
+			fmt.Fprint(p.w, ` fill-rule="evenodd"`)
+		}
+	} else {
+		fmt.Fprint(p.w, ` fill="none"`)
+	}
+	if stroke != nil {
+		r, g, b := rgb8(stroke)
+		fmt.Fprintf(p.w, ` stroke="#%02x%02x%02x" stroke-width="%.3f"`+
+			` stroke-linecap="round" stroke-linejoin="round"`, r, g, b, lineWidth)
+	}
+}
+
+// svgFontFamilies are the generic svg font families for draw2d's families
+var svgFontFamilies = map[draw2d.FontFamily]string{
+	draw2d.FontFamilySans:  "sans-serif",
+	draw2d.FontFamilySerif: "serif",
+	draw2d.FontFamilyMono:  "monospace",
+}
+
+func (p *svgPainter) text(s string, x, y, size float64, family draw2d.FontFamily,
+	fill, stroke color.Color, lineWidth float64) {
+	fmt.Fprintf(p.w, `<text x="%.3f" y="%.3f" font-family="%s" font-size="%.3f"`,
+		x, y, svgFontFamilies[family], size)
+	p.paintAttrs(fill, stroke, draw2d.FillRuleWinding, lineWidth)
+	fmt.Fprint(p.w, ` xml:space="preserve">`)
+	xml.EscapeText(p.w, []byte(s))
+	fmt.Fprint(p.w, "</text>\n")
+}
+
+// newSnapshotPdf returns a pdf document with a single page of width by
+// height points, set up like draw2dpdf.NewPdf does for named page sizes
+func newSnapshotPdf(width, height float64) *gofpdf.Fpdf {
+	pdf := gofpdf.NewCustom(&gofpdf.InitType{
+		UnitStr:    "pt",
+		Size:       gofpdf.SizeType{Wd: width, Ht: height},
+		FontDirStr: draw2d.GetFontFolder(),
+	})
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetDrawColor(0, 0, 0)
+	pdf.SetFillColor(255, 255, 255)
+	pdf.SetLineCapStyle("round")
+	pdf.SetLineJoinStyle("round")
+	pdf.SetLineWidth(1)
+	pdf.AddPage()
+	// draw2dpdf sets the font size on Restore, which refers to the current
+	// font, so select a core font that needs no font files
+	pdf.SetFont("Helvetica", "", 12)
+	return pdf
+}
+
+// WriteSnapshot draws the world state ws scaled by scale as an svg or pdf
+// document to w, where format is "svg" or "pdf"
+func WriteSnapshot(w io.Writer, format string, ws *WorldState, scale float64) error {
+	bounds := ws.FrameBounds()
+	width := float64(bounds.Dx()) * scale
+	height := float64(bounds.Dy()) * scale
+	switch format {
+	case "svg":
+		bw := bufio.NewWriter(w)
+		fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" `+
+			`width="%.3f" height="%.3f" viewBox="0 0 %.3f %.3f">`+"\n",
+			width, height, width, height)
+		gc := newVectorGraphicContext(&svgPainter{w: bw})
+		gc.Scale(scale, scale)
+		DrawWorld(gc, ws)
+		fmt.Fprint(bw, "</svg>\n")
+		return bw.Flush()
+	case "pdf":
+		pdf := newSnapshotPdf(width, height)
+		gc := draw2dpdf.NewGraphicContext(pdf)
+		gc.Save()
+		gc.Scale(scale, scale)
+		DrawWorld(gc, ws)
+		gc.Restore()
+		return pdf.Output(w)
+	}
+	return fmt.Errorf("snapshot: unknown format %q", format)
+}
+
+// SaveSnapshot writes a snapshot of ws to path, choosing the format from the
+// path extension, see WriteSnapshot
+func SaveSnapshot(path string, ws *WorldState, scale float64) error {
+	format := strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	if format != "svg" && format != "pdf" {
+		return fmt.Errorf("snapshot: unknown format for %q, use .svg or .pdf", path)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = WriteSnapshot(f, format, ws, scale)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
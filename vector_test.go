@@ -0,0 +1,196 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"golang.org/x/image/math/fixed"
+)
+
+// snapshotState returns the world state of a short run of the pillars
+// scenario, so that snapshots have creatures, obstacles and walls
+func snapshotState(t *testing.T) *WorldState {
+	cfg, err := LoadScenario("pillars")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSim(cfg, 1)
+	for i := 0; i < 50; i++ {
+		s.DoTick()
+	}
+	ws := s.State()
+	if len(ws.Creatures) == 0 || len(ws.Obstacles) == 0 || len(ws.Walls) == 0 {
+		t.Fatalf("state has %d creatures, %d obstacles and %d walls",
+			len(ws.Creatures), len(ws.Obstacles), len(ws.Walls))
+	}
+	return ws
+}
+
+func TestWriteSnapshotSVG(t *testing.T) {
+	ws := snapshotState(t)
+	var buf bytes.Buffer
+	if err := WriteSnapshot(&buf, "svg", ws, 2); err != nil {
+		t.Fatal(err)
+	}
+	d := xml.NewDecoder(&buf)
+	var root string
+	paths := 0
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("parsing svg: %v", err)
+		}
+		e, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch {
+		case root == "":
+			root = e.Name.Local
+			want := fmt.Sprintf("%.3f", float64(ws.FrameBounds().Dx())*2)
+			if got := xmlAttr(e, "width"); got != want {
+				t.Errorf("svg width %q, want %q", got, want)
+			}
+		case e.Name.Local == "path":
+			paths++
+			if xmlAttr(e, "d") == "" {
+				t.Errorf("path without data: %v", e)
+			}
+		}
+	}
+	if root != "svg" {
+		t.Errorf("root element %q, want svg", root)
+	}
+	// the background, border and at least one path per creature
+	if paths < len(ws.Creatures)+2 {
+		t.Errorf("%d paths for %d creatures", paths, len(ws.Creatures))
+	}
+}
+
+// xmlAttr returns the value of the attribute of e named name
+func xmlAttr(e xml.StartElement, name string) string {
+	for _, a := range e.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func TestWriteSnapshotPDF(t *testing.T) {
+	ws := snapshotState(t)
+	var buf bytes.Buffer
+	if err := WriteSnapshot(&buf, "pdf", ws, 0.5); err != nil {
+		t.Fatal(err)
+	}
+	b := buf.Bytes()
+	if !bytes.HasPrefix(b, []byte("%PDF-1.")) {
+		t.Fatalf("pdf starts with %q", b[:8])
+	}
+	if !bytes.HasSuffix(bytes.TrimSpace(b), []byte("%%EOF")) {
+		t.Fatalf("pdf does not end with %%%%EOF")
+	}
+	// the cross reference table must point at each object
+	m := regexp.MustCompile(`startxref\s+(\d+)\s+%%EOF\s*$`).FindSubmatch(b)
+	if m == nil {
+		t.Fatal("pdf has no startxref")
+	}
+	xref, _ := strconv.Atoi(string(m[1]))
+	if xref >= len(b) || !bytes.HasPrefix(b[xref:], []byte("xref")) {
+		t.Fatalf("startxref %d does not point at the xref table", xref)
+	}
+	m = regexp.MustCompile(`^xref\s+0 (\d+)\s+`).FindSubmatch(b[xref:])
+	if m == nil {
+		t.Fatal("malformed xref table")
+	}
+	n, _ := strconv.Atoi(string(m[1]))
+	entries := regexp.MustCompile(`(\d{10}) (\d{5}) ([nf])`).FindAllSubmatch(b[xref:], n)
+	if len(entries) != n {
+		t.Fatalf("xref table has %d entries, want %d", len(entries), n)
+	}
+	for i, e := range entries {
+		if string(e[3]) != "n" {
+			continue
+		}
+		off, _ := strconv.Atoi(string(e[1]))
+		if want := fmt.Sprintf("%d 0 obj", i); !bytes.HasPrefix(b[off:], []byte(want)) {
+			t.Errorf("xref entry %d points at %q, want %q", i, b[off:off+10], want)
+		}
+	}
+	bounds := ws.FrameBounds()
+	box := fmt.Sprintf("/MediaBox [0 0 %.2f %.2f]",
+		float64(bounds.Dx())*0.5, float64(bounds.Dy())*0.5)
+	if !bytes.Contains(b, []byte(box)) {
+		t.Errorf("pdf has no %s", box)
+	}
+}
+
+func TestVectorText(t *testing.T) {
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(&buf)
+	gc := newVectorGraphicContext(&svgPainter{w: bw})
+	gc.Scale(2, 2)
+	gc.SetFillColor(color.RGBA{0x12, 0x34, 0x56, 0xff})
+	text := "best <10> & more"
+	width := fillText(gc, text, 10, 20)
+	bw.Flush()
+	if want := textWidth(text); math.Abs(width-want) > 1e-9 {
+		t.Errorf("fillText() = %v, textWidth() = %v", width, want)
+	}
+
+	var e struct {
+		X       string `xml:"x,attr"`
+		Y       string `xml:"y,attr"`
+		Family  string `xml:"font-family,attr"`
+		Size    string `xml:"font-size,attr"`
+		Fill    string `xml:"fill,attr"`
+		Text    string `xml:",chardata"`
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &e); err != nil {
+		t.Fatalf("parsing %q: %v", buf.String(), err)
+	}
+	ascent := float64(textFont.Bounds(fixed.Int26_6(textSize*64)).Max.Y) / 64
+	want := []string{"text", "20.000", fmt.Sprintf("%.3f", (20+ascent)*2),
+		"monospace", fmt.Sprintf("%.3f", textSize*2), "#123456", text}
+	got := []string{e.XMLName.Local, e.X, e.Y, e.Family, e.Size, e.Fill, e.Text}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got text element %q, want %q", got, want)
+	}
+}
+
+func TestSaveSnapshotFormat(t *testing.T) {
+	if err := SaveSnapshot("arena.png", &WorldState{}, 1); err == nil {
+		t.Error("SaveSnapshot() with a png path succeeded")
+	}
+	if err := WriteSnapshot(&bytes.Buffer{}, "eps", &WorldState{}, 1); err == nil {
+		t.Error("WriteSnapshot() with format eps succeeded")
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2015 Benjamin Elder ("BenTheElder")
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/llgcode/draw2d"
+	"github.com/llgcode/draw2d/draw2dkit"
+)
+
+// wallWidth is the thickness of the static wall lines
+const wallWidth = 4
+
+// WallColor is the color static walls are drawn in
+var WallColor = color.RGBA{0x50, 0x50, 0x50, 0xFF}
+
+// Wall is a static line segment creatures must avoid, unlike obstacles walls
+// do not move and stay until they are removed. Walls with no length are
+// round pillars.
+type Wall struct {
+	id     int
+	x1, y1 float64
+	x2, y2 float64
+	width  float64
+}
+
+// WallState is the drawable state of a Wall
+type WallState struct {
+	ID     int
+	X1, Y1 float64
+	X2, Y2 float64
+	Width  float64
+}
+
+// AddWall adds a static wall from (x1, y1) to (x2, y2) in the simulation
+// area and returns its id
+func (s *Sim) AddWall(x1, y1, x2, y2 float64) int {
+	return s.addWall(x1, y1, x2, y2, wallWidth)
+}
+
+// addWall is AddWall for walls of any width
+func (s *Sim) addWall(x1, y1, x2, y2, width float64) int {
+	s.nextWallID++
+	s.walls = append(s.walls, Wall{
+		id:    s.nextWallID,
+		x1:    x1,
+		y1:    y1,
+		x2:    x2,
+		y2:    y2,
+		width: width,
+	})
+	return s.nextWallID
+}
+
+// nearestWall returns the id of and distance to the wall closest to (x, y)
+// or 0 if there are no walls
+func (s *Sim) nearestWall(x, y float64) (id int, dist float64) {
+	best := math.MaxFloat64
+	for i := range s.walls {
+		l := &s.walls[i]
+		if dist := wallEdgeDist(x, y, l.x1, l.y1, l.x2, l.y2, l.width); dist < best {
+			best = dist
+			id = l.id
+		}
+	}
+	return id, best
+}
+
+// wallEdgeDist returns the distance from (x, y) to the edge of a wall of
+// width from (x1, y1) to (x2, y2), or zero if (x, y) is inside the wall
+func wallEdgeDist(x, y, x1, y1, x2, y2, width float64) float64 {
+	return math.Max(0, segmentDist(x, y, x1, y1, x2, y2)-width/2)
+}
+
+// drawWalls draws the static walls
+func drawWalls(gc draw2d.GraphicContext, w *WorldState) {
+	borderWidthf := float64(w.BorderWidth)
+	// drawObstacles relies on the default stroke color
+	gc.Save()
+	defer gc.Restore()
+	gc.SetStrokeColor(WallColor)
+	gc.SetFillColor(WallColor)
+	gc.SetLineCap(draw2d.RoundCap)
+	for i := range w.Walls {
+		l := &w.Walls[i]
+		if l.X1 == l.X2 && l.Y1 == l.Y2 {
+			draw2dkit.Circle(gc, borderWidthf+l.X1, borderWidthf+l.Y1, l.Width/2)
+			gc.Fill()
+			continue
+		}
+		gc.SetLineWidth(l.Width)
+		gc.MoveTo(borderWidthf+l.X1, borderWidthf+l.Y1)
+		gc.LineTo(borderWidthf+l.X2, borderWidthf+l.Y2)
+		gc.Stroke()
+	}
+}